@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/strongcodr/lowcodefusion/pkg/cache"
+)
+
+var (
+	pruneOlderThan string
+	pruneKeep      int
+)
+
+func init() {
+	cacheCmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the local cache of downloaded integration packages",
+	}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List cached integration packages",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pkgCache, err := newCache()
+			if err != nil {
+				return err
+			}
+			entries, err := pkgCache.List()
+			if err != nil {
+				return err
+			}
+			if len(entries) == 0 {
+				fmt.Printf("No cached packages in %s\n", pkgCache.Dir)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "INTEGRATION\tVERSION\tSIZE\tLAST USED\tSHA256")
+			for _, e := range entries {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+					e.Integration, e.Version, e.Size, e.LastUsed.Local().Format(time.DateTime), shortSum(e.SHA256))
+			}
+			return w.Flush()
+		},
+	}
+
+	prune := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove old cached packages and unreferenced files",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pkgCache, err := newCache()
+			if err != nil {
+				return err
+			}
+
+			var olderThan time.Duration
+			if pruneOlderThan != "" {
+				if olderThan, err = parseAge(pruneOlderThan); err != nil {
+					return err
+				}
+			}
+
+			removed, err := pkgCache.Prune(cache.PruneOptions{OlderThan: olderThan, Keep: pruneKeep})
+			for _, e := range removed {
+				fmt.Printf("Removed %s %s\n", e.Integration, e.Version)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Pruned %d packages from %s\n", len(removed), pkgCache.Dir)
+			return nil
+		},
+	}
+	prune.Flags().StringVarP(&pruneOlderThan, "older-than", "", "", "Remove packages not used for this long (e.g. 30d, 12h)")
+	prune.Flags().IntVarP(&pruneKeep, "keep", "", 0, "Keep only the newest N versions of each integration")
+
+	clearCmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Remove all cached packages",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pkgCache, err := newCache()
+			if err != nil {
+				return err
+			}
+			if err := pkgCache.Clear(); err != nil {
+				return err
+			}
+			fmt.Printf("Cleared %s\n", pkgCache.Dir)
+			return nil
+		},
+	}
+
+	cacheCmd.AddCommand(list, prune, clearCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
+
+// parseAge parses a duration that may also be given in days (e.g. "30d")
+func parseAge(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid age %q: %w", s, err)
+	}
+	return d, nil
+}
+
+// shortSum abbreviates a digest for display
+func shortSum(sum string) string {
+	if len(sum) > 12 {
+		return sum[:12]
+	}
+	return sum
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/strongcodr/lowcodefusion/pkg/cache"
+	"github.com/strongcodr/lowcodefusion/pkg/config"
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+)
+
+// newFetcher builds a fetcher client from the config file, environment and flags.
+// Flags take precedence over environment variables, which take precedence over the config file.
+func newFetcher() (*fetcher.Client, error) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := fetcher.Options{
+		BaseURL:       cfg.BaseURL,
+		DetailsPath:   cfg.Endpoints.Details,
+		DownloadPath:  cfg.Endpoints.Download,
+		ListPath:      cfg.Endpoints.List,
+		SearchPath:    cfg.Endpoints.Search,
+		ChecksumPath:  cfg.Endpoints.Checksum,
+		SignaturePath: cfg.Endpoints.Signature,
+	}
+	if baseURL != "" {
+		opts.BaseURL = baseURL
+	}
+
+	// Credential flags replace whatever the config file or environment configured
+	auth := cfg.Auth
+	switch {
+	case authToken != "":
+		auth = config.Auth{Type: "bearer", Token: authToken, Header: apiKeyHeader}
+	case authTokenFile != "":
+		auth = config.Auth{Type: "bearer", TokenFile: authTokenFile, Header: apiKeyHeader}
+	case apiKey != "":
+		auth = config.Auth{Type: "api_key", Token: apiKey, Header: apiKeyHeader}
+	case apiKeyHeader != "":
+		auth.Header = apiKeyHeader
+	}
+	creds, err := auth.Credentials()
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth configuration: %w", err)
+	}
+	opts.Credentials = creds
+
+	if err := applyHTTPSettings(&opts, cfg.HTTP); err != nil {
+		return nil, err
+	}
+
+	return fetcher.NewClient(opts), nil
+}
+
+// applyHTTPSettings fills timeouts and retries from the config file, then from flags that were set
+func applyHTTPSettings(opts *fetcher.Options, settings config.HTTP) error {
+	durations := []struct {
+		name  string
+		value string
+		dst   *time.Duration
+	}{
+		{"http.timeout", settings.Timeout, &opts.Timeout},
+		{"http.download_timeout", settings.DownloadTimeout, &opts.DownloadTimeout},
+		{"http.retry_backoff", settings.RetryBackoff, &opts.RetryBackoff},
+	}
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s in config: %w", d.name, err)
+		}
+		*d.dst = parsed
+	}
+	if settings.Retries != nil {
+		opts.Retries = *settings.Retries
+	}
+
+	flags := rootCmd.PersistentFlags()
+	if flags.Changed("timeout") {
+		opts.Timeout = httpTimeout
+	}
+	if flags.Changed("download-timeout") {
+		opts.DownloadTimeout = downloadTimeout
+	}
+	if flags.Changed("retries") {
+		opts.Retries = retries
+	}
+	if flags.Changed("retry-backoff") {
+		opts.RetryBackoff = retryBackoff
+	}
+
+	// Zero means "use the default" to the fetcher, so map an explicit 0 retries to "none"
+	if opts.Retries == 0 && (flags.Changed("retries") || settings.Retries != nil) {
+		opts.Retries = -1
+	}
+	return nil
+}
+
+// newCache opens the package cache configured by flag, environment or config file
+func newCache() (*cache.Cache, error) {
+	if cacheDir != "" {
+		return cache.New(cacheDir), nil
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, err
+	}
+	return cache.New(cfg.CacheDir), nil
+}
+
+// newVerifier builds the package verification settings from the config file, environment and flags
+func newVerifier() (*verifier, error) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &verifier{sha256: expectSHA256, signatureFile: signatureFile}
+
+	keyPath := cfg.Verify.PublicKey
+	if publicKeyFile != "" {
+		keyPath = publicKeyFile
+	}
+	if keyPath != "" {
+		if v.publicKey, err = fetcher.LoadPublicKey(keyPath); err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewFetcherTokenInCustomHeader(t *testing.T) {
+	var gotHeader, gotAuthorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Pliant-Token")
+		gotAuthorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"result": []}`))
+	}))
+	defer srv.Close()
+
+	// An empty config file keeps the user's configuration out of the test
+	cfgFile := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(cfgFile, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("LCF_TOKEN", "")
+	t.Setenv("LCF_API_KEY", "")
+	t.Setenv("LCF_BASE_URL", "")
+	setFlags(t, map[*string]string{
+		&configPath:   cfgFile,
+		&baseURL:      srv.URL,
+		&authToken:    "secret",
+		&apiKeyHeader: "X-Pliant-Token",
+	})
+
+	client, err := newFetcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListIntegrations(); err != nil {
+		t.Fatal(err)
+	}
+	if gotHeader != "secret" || gotAuthorization != "" {
+		t.Errorf("X-Pliant-Token = %q, Authorization = %q, want the token only in X-Pliant-Token", gotHeader, gotAuthorization)
+	}
+}
+
+// setFlags sets flag variables for one test and restores them afterwards
+func setFlags(t *testing.T, values map[*string]string) {
+	t.Helper()
+	for ptr, value := range values {
+		ptr, old := ptr, *ptr
+		t.Cleanup(func() { *ptr = old })
+		*ptr = value
+	}
+}
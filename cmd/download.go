@@ -1,19 +1,23 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
-	"github.com/strongcodr/lowcodefusion/pkg/generator/python"
+	"github.com/strongcodr/lowcodefusion/pkg/lockfile"
 )
 
 var (
 	integration string
 	lang        string
 	outDir      string
+	pinVersion  string
+	locked      bool
+	offline     bool
 )
 
 func init() {
@@ -24,10 +28,29 @@ func init() {
 			// Check if we should only download the zip
 			downloadOnly, _ := cmd.Flags().GetBool("download-only")
 
-			// fetch integration definition
-			def, err := fetcher.FetchIntegration(integration)
-			if err != nil {
-				return err
+			// fail on an unknown language or unsupported flags before downloading anything
+			if !downloadOnly {
+				if _, err := selectGenerator(cmd); err != nil {
+					return err
+				}
+			}
+
+			// In locked mode the lockfile decides which version to fetch
+			var lockEntry lockfile.Entry
+			if locked {
+				lock, err := lockfile.Read(outDir)
+				if err != nil {
+					return fmt.Errorf("--locked requires %s: %w", lockfile.Path(outDir), err)
+				}
+				entry, ok := lock.Find(integration)
+				if !ok {
+					return fmt.Errorf("--locked: %s has no entry for %s", lockfile.Path(outDir), integration)
+				}
+				if pinVersion != "" && pinVersion != entry.Version {
+					return fmt.Errorf("--locked: --version %s conflicts with locked version %s", pinVersion, entry.Version)
+				}
+				lockEntry = entry
+				pinVersion = entry.Version
 			}
 
 			// create a temporary directory for staging
@@ -36,20 +59,36 @@ func init() {
 				return fmt.Errorf("creating temp dir: %w", err)
 			}
 
-			// Only clean up if we're not in download-only mode
-			if !downloadOnly {
-				defer os.RemoveAll(tmpDir)
-			}
+			// The directory is kept only for a successful download-only run
+			keepTmpDir := false
+			defer func() {
+				if !keepTmpDir {
+					os.RemoveAll(tmpDir)
+				}
+			}()
 
-			// download assets to the temp directory
-			zipPath, err := fetcher.DownloadPackage(def, tmpDir)
+			// resolve the version and get its package from the cache or the server
+			def, zipPath, fetched, err := obtainPackage(integration, pinVersion, offline, tmpDir)
 			if err != nil {
 				return err
 			}
 
+			if locked {
+				if err := lockEntry.Check(fetched); err != nil {
+					return fmt.Errorf("--locked: %w", err)
+				}
+				fmt.Printf("Package matches %s (sha256 %s)\n", lockfile.FileName, fetched.SHA256)
+			}
+
 			// If download-only flag is set, just print the path and exit
 			if downloadOnly {
-				fmt.Printf("\nDownload complete. Zip file saved to: %s\n", zipPath)
+				// The cache keeps its blob, the caller gets a copy under the package file name
+				pkgPath, err := copyPackage(zipPath, tmpDir, def.FileName)
+				if err != nil {
+					return err
+				}
+				keepTmpDir = true
+				fmt.Printf("\nDownload complete. Zip file saved to: %s\n", pkgPath)
 				fmt.Printf("Temporary directory: %s\n", tmpDir)
 				return nil
 			}
@@ -60,21 +99,46 @@ func init() {
 			}
 
 			// generate stubs
-			switch lang {
-			case "python":
-				return python.GenerateStubs(def, tmpDir, outDir)
-			default:
-				return fmt.Errorf("unsupported language: %s", lang)
+			if err := generateSDK(def, tmpDir); err != nil {
+				return err
+			}
+
+			// write the lockfile next to the generated SDK
+			if !locked {
+				return writeLockEntry(outDir, fetched)
 			}
+			return nil
 		},
 	}
 	down.Flags().StringVarP(&integration, "integration", "", "", "Integration name (e.g. AWS)")
-	down.Flags().StringVarP(&lang, "lang", "", "python", "Target language (python)")
+	addGeneratorFlags(down)
 	down.Flags().StringVarP(&outDir, "out", "", ".", "Output directory")
+	down.Flags().StringVarP(&pinVersion, "version", "", "", "Integration version or range (e.g. 1.1.118, ~1.1.0, ^1.0.0; default latest)")
+	down.Flags().StringVarP(&expectSHA256, "sha256", "", "", "Expected SHA-256 of the package zip")
+	down.Flags().StringVarP(&signatureFile, "signature", "", "", "Detached signature of the package zip")
 	down.Flags().BoolP("download-only", "", false, "Only download the zip file and print its path")
+	down.Flags().BoolVarP(&offline, "offline", "", false, "Only use packages from the local cache")
+	down.Flags().BoolVarP(&locked, "locked", "", false, "Fail unless the fetched package matches "+lockfile.FileName+" in the output directory")
 	down.MarkFlagRequired("integration")
 	// down.MarkFlagRequired("lang")
 	// down.MarkFlagRequired("out")
 	// down.MarkFlagRequired("download-only")
 	rootCmd.AddCommand(down)
 }
+
+// writeLockEntry adds or updates an integration in the lockfile of an output directory
+func writeLockEntry(dir string, entry lockfile.Entry) error {
+	lock, err := lockfile.Read(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		lock = &lockfile.Lockfile{}
+	} else if err != nil {
+		return err
+	}
+
+	lock.Set(entry)
+	if err := lock.Write(dir); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %s\n", lockfile.Path(dir))
+	return nil
+}
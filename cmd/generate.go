@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+	"github.com/strongcodr/lowcodefusion/pkg/source"
+)
+
+var fromPath string
+
+func init() {
+	gen := &cobra.Command{
+		Use:   "generate",
+		Short: "Scaffold an SDK from a local integration package",
+		Long: "Scaffold an SDK from a package zip (e.g. ./AWS_1.1.118.ssi.zip) or an already-extracted\n" +
+			"directory without contacting the Pliant server. The integration name and version are\n" +
+			"derived from the package.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// fail on an unknown language or unsupported flags before doing any work
+			if _, err := selectGenerator(cmd); err != nil {
+				return err
+			}
+
+			// verify a local zip before it is extracted
+			if err := verifyLocalPackage(fromPath); err != nil {
+				return err
+			}
+
+			pkg, err := source.Open(fromPath, integration)
+			if err != nil {
+				return err
+			}
+			defer pkg.Close()
+
+			def := pkg.Def
+			if def.Version != "" {
+				fmt.Printf("Generating %s %s from %s\n", def.Name, def.Version, fromPath)
+			} else {
+				fmt.Printf("Generating %s from %s (version unknown)\n", def.Name, fromPath)
+			}
+
+			// generate stubs
+			if err := generateSDK(def, pkg.Dir); err != nil {
+				return err
+			}
+
+			// Only zips can be pinned by checksum
+			if pkg.ZipPath == "" {
+				return nil
+			}
+			sum, err := fetcher.FileSHA256(pkg.ZipPath)
+			if err != nil {
+				return err
+			}
+			abs, err := filepath.Abs(pkg.ZipPath)
+			if err != nil {
+				return err
+			}
+			def.DownloadURL = "file://" + filepath.ToSlash(abs)
+			return writeLockEntry(outDir, newLockEntry(def, sum))
+		},
+	}
+	gen.Flags().StringVarP(&fromPath, "from", "", "", "Package zip or extracted package directory")
+	gen.Flags().StringVarP(&integration, "integration", "", "", "Integration name (default derived from the package)")
+	addGeneratorFlags(gen)
+	gen.Flags().StringVarP(&outDir, "out", "", ".", "Output directory")
+	gen.Flags().StringVarP(&expectSHA256, "sha256", "", "", "Expected SHA-256 of the package zip")
+	gen.Flags().StringVarP(&signatureFile, "signature", "", "", "Detached signature of the package zip (default <zip>.sig if present)")
+	gen.MarkFlagRequired("from")
+	rootCmd.AddCommand(gen)
+}
+
+// generateSDK scaffolds the SDK for the selected language from an extracted package
+func generateSDK(def *fetcher.IntegrationDef, srcDir string) error {
+	g, err := generator.Get(lang)
+	if err != nil {
+		return err
+	}
+
+	integration, err := ir.Load(srcDir, def.Name)
+	if err != nil {
+		return err
+	}
+	integration.Version = def.Version
+
+	return g.Generate(integration, outDir)
+}
+
+// verifyLocalPackage checks a local package zip before extraction.
+// Extracted directories cannot be verified, so asking for it is an error.
+func verifyLocalPackage(path string) error {
+	v, err := newVerifier()
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("cannot open package: %w", err)
+	}
+	if info.IsDir() {
+		if v.sha256 != "" || v.publicKey != nil {
+			return fmt.Errorf("%s is a directory: checksums and signatures can only be verified for package zips", path)
+		}
+		return nil
+	}
+
+	// Pick up a signature shipped next to the zip
+	var signature []byte
+	if v.publicKey != nil && v.signatureFile == "" {
+		if data, err := os.ReadFile(path + ".sig"); err == nil {
+			signature = data
+		}
+	}
+	return v.verify(path, signature)
+}
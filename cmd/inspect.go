@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+	"github.com/strongcodr/lowcodefusion/pkg/source"
+)
+
+var inspectOutput string
+
+func init() {
+	inspect := &cobra.Command{
+		Use:   "inspect <package.zip|directory>",
+		Short: "Summarize an integration package without generating code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pkg, err := source.Open(args[0], integration)
+			if err != nil {
+				return err
+			}
+			defer pkg.Close()
+
+			loaded, err := ir.Load(pkg.Dir, pkg.Def.Name)
+			if err != nil {
+				return err
+			}
+			loaded.Version = pkg.Def.Version
+			summary := ir.Summarize(loaded)
+
+			switch inspectOutput {
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(summary)
+			case "text":
+				printSummary(summary)
+				return nil
+			default:
+				return fmt.Errorf("unsupported output format: %s (expected text or json)", inspectOutput)
+			}
+		},
+	}
+	inspect.Flags().StringVarP(&integration, "integration", "", "", "Integration name (default derived from the package)")
+	inspect.Flags().StringVarP(&inspectOutput, "output", "o", "text", "Output format (text, json)")
+	rootCmd.AddCommand(inspect)
+}
+
+// printSummary writes a human-readable package summary
+func printSummary(summary *ir.PackageSummary) {
+	title := summary.Integration
+	if summary.Version != "" {
+		title += " " + summary.Version
+	}
+	fmt.Println(title)
+	fmt.Printf("%d operations in %d services\n", summary.Operations, len(summary.Services))
+	fmt.Printf("Schemas: %d complex, %d definitions, %d using oneOf, %d circular references\n",
+		summary.Schemas.Schemas, summary.Schemas.Definitions, summary.Schemas.OneOf, summary.Schemas.CircularRefs)
+
+	for _, service := range summary.Services {
+		fmt.Printf("\nService %s (%d operations)\n", service.Name, len(service.Operations))
+		for _, op := range service.Operations {
+			fmt.Printf("  %s\n", op.Name)
+			if op.Description != "" {
+				fmt.Printf("    %s\n", strings.SplitN(op.Description, "\n", 2)[0])
+			}
+
+			inputs := make([]string, 0, len(op.Parameters))
+			for _, p := range op.Parameters {
+				input := fmt.Sprintf("%s: %s", p.Name, p.Type)
+				if p.Required {
+					input += " (required)"
+				}
+				inputs = append(inputs, input)
+			}
+			if len(inputs) == 0 {
+				inputs = append(inputs, "none")
+			}
+			fmt.Printf("    inputs:  %s\n", strings.Join(inputs, ", "))
+			fmt.Printf("    output:  %s\n", op.OutputType)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+
+	// Target languages register themselves with the generator registry
+	_ "github.com/strongcodr/lowcodefusion/pkg/generator/csharp"
+	_ "github.com/strongcodr/lowcodefusion/pkg/generator/golang"
+	_ "github.com/strongcodr/lowcodefusion/pkg/generator/java"
+	_ "github.com/strongcodr/lowcodefusion/pkg/generator/python"
+	_ "github.com/strongcodr/lowcodefusion/pkg/generator/typescript"
+)
+
+func init() {
+	langs := &cobra.Command{
+		Use:   "langs",
+		Short: "List the target languages lcf can generate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "LANG\tCAPABILITIES\tFLAGS\tDESCRIPTION")
+			for _, g := range generator.All() {
+				caps := make([]string, 0, len(g.Capabilities()))
+				for _, c := range g.Capabilities() {
+					caps = append(caps, string(c))
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+					g.Name(), orNone(strings.Join(caps, ",")), orNone(strings.Join(generatorFlags(g), ",")), g.Description())
+			}
+			return w.Flush()
+		},
+	}
+	rootCmd.AddCommand(langs)
+}
+
+// addGeneratorFlags adds --lang and the flags of every registered generator to a command
+func addGeneratorFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lang, "lang", "", "python",
+		fmt.Sprintf("Target language (%s)", strings.Join(generator.Names(), ", ")))
+	for _, g := range generator.All() {
+		cmd.Flags().AddFlagSet(generator.FlagSet(g))
+	}
+}
+
+// selectGenerator returns the generator chosen with --lang. Flags of other languages and
+// flags asking for a capability the generator lacks are errors rather than silently ignored.
+func selectGenerator(cmd *cobra.Command) (generator.Generator, error) {
+	selected, err := generator.Get(lang)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range generator.All() {
+		var flagErr error
+		generator.FlagSet(g).VisitAll(func(f *pflag.Flag) {
+			if flagErr != nil || !cmd.Flags().Changed(f.Name) {
+				return
+			}
+			if c := generator.FlagCapability(f); c != "" && !generator.Supports(selected, c) {
+				flagErr = fmt.Errorf("--%s asks for %s, which the %s generator does not support", f.Name, c, selected.Name())
+			} else if g != selected {
+				flagErr = fmt.Errorf("--%s only applies to --lang %s, not %s", f.Name, g.Name(), selected.Name())
+			}
+		})
+		if flagErr != nil {
+			return nil, flagErr
+		}
+	}
+	return selected, nil
+}
+
+// generatorFlags returns the names of a generator's own flags
+func generatorFlags(g generator.Generator) []string {
+	var names []string
+	generator.FlagSet(g).VisitAll(func(f *pflag.Flag) {
+		names = append(names, "--"+f.Name)
+	})
+	return names
+}
+
+// orNone shows "-" for empty table cells
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+)
+
+// parseGeneratorFlags parses args on a fresh command carrying the generator flags.
+// The flags are shared with the real commands, so the ones set are reset afterwards.
+func parseGeneratorFlags(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "generate"}
+	addGeneratorFlags(cmd)
+	oldLang := lang
+	t.Cleanup(func() {
+		lang = oldLang
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			if f.Changed {
+				f.Value.Set(f.DefValue)
+				f.Changed = false
+			}
+		})
+	})
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return cmd
+}
+
+func TestSelectGenerator(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    string // selected generator
+		wantErr string
+	}{
+		{args: []string{"--lang", "python", "--python-async", "--python-models", "pydantic"}, want: "python"},
+		{args: []string{"--lang", "go", "--go-module", "example.com/sdk"}, want: "go"},
+		{args: []string{"--lang", "typescript", "--python-async"}, wantErr: "--python-async asks for async, which the typescript generator does not support"},
+		{args: []string{"--lang", "go", "--python-models", "pydantic"}, wantErr: "--python-models only applies to --lang python, not go"},
+		{args: []string{"--lang", "java", "--csharp-namespace", "Acme"}, wantErr: "--csharp-namespace only applies to --lang csharp"},
+		{args: []string{"--lang", "cobol"}, wantErr: "unsupported language: cobol"},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			g, err := selectGenerator(parseGeneratorFlags(t, tt.args...))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("%v: error %v, want %q", tt.args, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || g.Name() != tt.want {
+				t.Errorf("%v: selected %v, %v, want %s", tt.args, g, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeneratorFlagsKeepValues(t *testing.T) {
+	cmd := parseGeneratorFlags(t, "--python-models", "dataclass")
+
+	python, err := generator.Get("python")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Listing the flags, as lcf langs does, must not reset the options behind them
+	if got := strings.Join(generatorFlags(python), ","); got != "--python-async,--python-format,--python-models" {
+		t.Errorf("generatorFlags = %s", got)
+	}
+	if got := cmd.Flags().Lookup("python-models").Value.String(); got != "dataclass" {
+		t.Errorf("--python-models = %s after listing the flags, want dataclass", got)
+	}
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+)
+
+var listOutput string
+
+func init() {
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List the integrations available on the Pliant server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newFetcher()
+			if err != nil {
+				return err
+			}
+			integrations, err := client.ListIntegrations()
+			if err != nil {
+				return err
+			}
+			return printIntegrations(integrations)
+		},
+	}
+	list.Flags().StringVarP(&listOutput, "output", "o", "table", "Output format (table, json)")
+
+	search := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search the integrations available on the Pliant server by name or description",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newFetcher()
+			if err != nil {
+				return err
+			}
+			integrations, err := client.SearchIntegrations(args[0])
+			if err != nil {
+				return err
+			}
+			return printIntegrations(integrations)
+		},
+	}
+	search.Flags().StringVarP(&listOutput, "output", "o", "table", "Output format (table, json)")
+
+	rootCmd.AddCommand(list, search)
+}
+
+// printIntegrations writes integration summaries in the selected output format
+func printIntegrations(integrations []fetcher.IntegrationSummary) error {
+	switch listOutput {
+	case "json":
+		if integrations == nil {
+			integrations = []fetcher.IntegrationSummary{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(integrations)
+	case "table":
+		if len(integrations) == 0 {
+			fmt.Println("No integrations found")
+			return nil
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tLATEST VERSION\tDESCRIPTION")
+		for _, i := range integrations {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", i.Name, i.LatestVersion, i.Description)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unsupported output format: %s (expected table or json)", listOutput)
+	}
+}
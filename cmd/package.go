@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/strongcodr/lowcodefusion/pkg/cache"
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+	"github.com/strongcodr/lowcodefusion/pkg/lockfile"
+)
+
+// obtainPackage resolves an integration version and returns its definition, the path
+// of its zip and the matching lockfile entry. Cached packages are reused, and packages
+// downloaded into stageDir are added to the cache. In offline mode only the cache is used.
+// The package is verified before it is returned, so callers can extract it safely.
+func obtainPackage(name, constraint string, offline bool, stageDir string) (*fetcher.IntegrationDef, string, lockfile.Entry, error) {
+	pkgCache, err := newCache()
+	if err != nil {
+		return nil, "", lockfile.Entry{}, err
+	}
+	v, err := newVerifier()
+	if err != nil {
+		return nil, "", lockfile.Entry{}, err
+	}
+
+	// Offline, pick the best cached version
+	if offline {
+		entry, blob, err := pkgCache.Resolve(name, constraint)
+		if err != nil {
+			return nil, "", lockfile.Entry{}, fmt.Errorf("offline: %w", err)
+		}
+		def := &fetcher.IntegrationDef{
+			Name:        entry.Integration,
+			Version:     entry.Version,
+			FileName:    entry.File,
+			DownloadURL: entry.URL,
+		}
+		fmt.Printf("Using cached package (offline): %s\n", blob)
+		if err := v.verify(blob, entry.Signature, expectation{source: "cache", sum: entry.SHA256}); err != nil {
+			return nil, "", lockfile.Entry{}, err
+		}
+		return def, blob, newLockEntry(def, entry.SHA256), nil
+	}
+
+	client, err := newFetcher()
+	if err != nil {
+		return nil, "", lockfile.Entry{}, err
+	}
+
+	// fetch integration definition for the requested version
+	def, err := client.ResolveVersion(name, constraint)
+	if err != nil {
+		return nil, "", lockfile.Entry{}, err
+	}
+
+	// fetch what the server publishes to verify the package with
+	published, err := client.FetchChecksum(def)
+	if err != nil {
+		return nil, "", lockfile.Entry{}, err
+	}
+	var signature []byte
+	if v.publicKey != nil {
+		if signature, err = client.FetchSignature(def); err != nil {
+			return nil, "", lockfile.Entry{}, err
+		}
+	}
+	serverSum := expectation{source: "server", sum: published}
+
+	// Reuse the cached package if we have it
+	if entry, blob, ok := pkgCache.Lookup(def.Name, def.Version); ok {
+		fmt.Printf("Using cached package: %s\n", blob)
+		if signature == nil {
+			signature = entry.Signature
+		}
+		if err := v.verify(blob, signature, serverSum, expectation{source: "cache", sum: entry.SHA256}); err != nil {
+			return nil, "", lockfile.Entry{}, err
+		}
+		return def, blob, newLockEntry(def, entry.SHA256), nil
+	}
+
+	// download into the cache so interrupted downloads can resume on the next run,
+	// falling back to the staging directory if the cache is not writable
+	downloadDir := pkgCache.DownloadDir()
+	if err := os.MkdirAll(downloadDir, 0755); err != nil {
+		downloadDir = stageDir
+	}
+	zipPath, err := client.DownloadPackage(def, downloadDir)
+	if err != nil {
+		return nil, "", lockfile.Entry{}, err
+	}
+	if err := v.verify(zipPath, signature, serverSum); err != nil {
+		os.Remove(zipPath)
+		return nil, "", lockfile.Entry{}, err
+	}
+
+	// Caching is best effort, fall back to the staged download
+	entry, blob, err := pkgCache.Put(cache.Entry{
+		Integration: def.Name,
+		Version:     def.Version,
+		File:        def.FileName,
+		URL:         def.DownloadURL,
+		Signature:   signature,
+	}, zipPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not cache package: %v\n", err)
+		sum, err := fetcher.FileSHA256(zipPath)
+		if err != nil {
+			return nil, "", lockfile.Entry{}, err
+		}
+		return def, zipPath, newLockEntry(def, sum), nil
+	}
+	fmt.Printf("Cached package: %s\n", blob)
+	os.Remove(zipPath)
+
+	return def, blob, newLockEntry(def, entry.SHA256), nil
+}
+
+// newLockEntry builds the lockfile entry of a package
+func newLockEntry(def *fetcher.IntegrationDef, sum string) lockfile.Entry {
+	return lockfile.Entry{
+		Name:    def.Name,
+		Version: def.Version,
+		File:    def.FileName,
+		URL:     def.DownloadURL,
+		SHA256:  sum,
+	}
+}
+
+// copyPackage copies a package zip into dir under its package file name and returns the copy's path
+func copyPackage(src, dir, fileName string) (string, error) {
+	dst := filepath.Join(dir, filepath.Base(fileName))
+	if dst == src {
+		return dst, nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to copy package to %s: %w", dst, err)
+	}
+	return dst, nil
+}
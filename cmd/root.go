@@ -3,8 +3,34 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+)
+
+var (
+	configPath string
+	baseURL    string
+	cacheDir   string
+
+	// credential flags
+	authToken     string
+	authTokenFile string
+	apiKey        string
+	apiKeyHeader  string
+
+	// verification flags
+	expectSHA256  string
+	signatureFile string
+	publicKeyFile string
+
+	// network flags
+	httpTimeout     time.Duration
+	downloadTimeout time.Duration
+	retries         int
+	retryBackoff    time.Duration
 )
 
 var rootCmd = &cobra.Command{
@@ -13,6 +39,21 @@ var rootCmd = &cobra.Command{
 	Long:  "lcf is a Pulumi-style SDK generator for Pliant integrations.",
 }
 
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "", "", "Config file (default $LCF_CONFIG or ~/.config/lcf/config.json)")
+	rootCmd.PersistentFlags().StringVarP(&baseURL, "base-url", "", "", "Pliant server base URL (default $LCF_BASE_URL or https://automation-library.ibm.com)")
+	rootCmd.PersistentFlags().StringVarP(&cacheDir, "cache-dir", "", "", "Package cache directory (default $LCF_CACHE_DIR or ~/.cache/lcf)")
+	rootCmd.PersistentFlags().DurationVarP(&httpTimeout, "timeout", "", fetcher.DefaultTimeout, "Timeout for API requests and response headers")
+	rootCmd.PersistentFlags().DurationVarP(&downloadTimeout, "download-timeout", "", 0, "Timeout for each package download attempt (0 for none)")
+	rootCmd.PersistentFlags().IntVarP(&retries, "retries", "", fetcher.DefaultRetries, "Retries for network errors and 5xx responses")
+	rootCmd.PersistentFlags().DurationVarP(&retryBackoff, "retry-backoff", "", fetcher.DefaultRetryBackoff, "Delay before the first retry, doubled for each further retry")
+	rootCmd.PersistentFlags().StringVarP(&publicKeyFile, "public-key", "", "", "PEM public key; packages must carry a valid detached signature (default $LCF_PUBLIC_KEY)")
+	rootCmd.PersistentFlags().StringVarP(&authToken, "token", "", "", "Bearer token for the Pliant server (default $LCF_TOKEN)")
+	rootCmd.PersistentFlags().StringVarP(&authTokenFile, "token-file", "", "", "File containing the bearer token for the Pliant server")
+	rootCmd.PersistentFlags().StringVarP(&apiKey, "api-key", "", "", "API key for the Pliant server (default $LCF_API_KEY)")
+	rootCmd.PersistentFlags().StringVarP(&apiKeyHeader, "api-key-header", "", "", "Header used to send the API key or token (default X-API-Key for API keys)")
+}
+
 // Execute runs the root command
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
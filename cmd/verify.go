@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"crypto"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+)
+
+// verifier checks package integrity before anything is extracted
+type verifier struct {
+	sha256        string           // digest supplied with --sha256
+	signatureFile string           // detached signature supplied with --signature
+	publicKey     crypto.PublicKey // signatures are required when set
+}
+
+// expectation is a digest a package must match and where it came from
+type expectation struct {
+	source string
+	sum    string
+}
+
+// verify checks a package zip against every known digest and, if a public key is
+// configured, its detached signature. published are digests from the server or lockfile.
+func (v *verifier) verify(zipPath string, signature []byte, published ...expectation) error {
+	var expected []expectation
+	for _, e := range append(published, expectation{source: "--sha256", sum: v.sha256}) {
+		if e.sum != "" {
+			expected = append(expected, e)
+		}
+	}
+
+	var sources []string
+	for _, e := range expected {
+		if err := fetcher.VerifySHA256(zipPath, e.sum, e.source); err != nil {
+			return err
+		}
+		sources = append(sources, e.source)
+	}
+	if len(sources) > 0 {
+		fmt.Fprintf(os.Stderr, "Verified sha256 against %s\n", strings.Join(sources, ", "))
+	}
+
+	if v.publicKey == nil {
+		return nil
+	}
+
+	// An explicit signature file wins over the published one
+	if v.signatureFile != "" {
+		data, err := os.ReadFile(v.signatureFile)
+		if err != nil {
+			return fmt.Errorf("failed to read signature: %w", err)
+		}
+		signature = data
+	}
+	if len(signature) == 0 {
+		return fmt.Errorf("a public key is configured but no signature is available for %s (use --signature or configure endpoints.signature)", zipPath)
+	}
+	if err := fetcher.VerifySignature(zipPath, signature, v.publicKey); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "Verified package signature")
+	return nil
+}
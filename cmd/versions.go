@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	versions := &cobra.Command{
+		Use:   "versions <integration>",
+		Short: "List the available versions of a Pliant integration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newFetcher()
+			if err != nil {
+				return err
+			}
+
+			list, err := client.ListVersions(args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, v := range list {
+				fmt.Println(v)
+			}
+			return nil
+		},
+	}
+	rootCmd.AddCommand(versions)
+}
@@ -2,9 +2,9 @@ module github.com/strongcodr/lowcodefusion
 
 go 1.21
 
-require github.com/spf13/cobra v1.6.1
-
 require (
-	github.com/inconshreveable/mousetrap v1.0.1 // indirect
-	github.com/spf13/pflag v1.0.5 // indirect
+	github.com/spf13/cobra v1.6.1
+	github.com/spf13/pflag v1.0.5
 )
+
+require github.com/inconshreveable/mousetrap v1.0.1 // indirect
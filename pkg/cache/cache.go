@@ -0,0 +1,426 @@
+// File: pkg/cache/cache.go
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/strongcodr/lowcodefusion/pkg/semver"
+)
+
+// Cache is a content-addressed store of downloaded integration packages.
+//
+// Layout:
+//
+//	<dir>/blobs/sha256/<digest>            package bytes, named by their SHA-256
+//	<dir>/index/<integration>/<version>.json  metadata pointing at a blob
+//	<dir>/downloads/                        downloads in progress, resumable across runs
+type Cache struct {
+	Dir string
+}
+
+// Entry describes a cached package
+type Entry struct {
+	Integration string    `json:"integration"`         // e.g. "AWS"
+	Version     string    `json:"version"`             // e.g. "1.1.118"
+	File        string    `json:"file"`                // e.g. "AWS_1.1.118.ssi.zip"
+	URL         string    `json:"url"`                 // where the package was downloaded from
+	SHA256      string    `json:"sha256"`              // hex-encoded digest of the package
+	Signature   []byte    `json:"signature,omitempty"` // detached signature published with the package
+	Size        int64     `json:"size"`                // package size in bytes
+	Added       time.Time `json:"added"`               // when the package was cached
+	LastUsed    time.Time `json:"lastUsed"`            // when the package was last used
+}
+
+// DefaultDir returns the default cache directory (e.g. ~/.cache/lcf, honoring $XDG_CACHE_HOME)
+func DefaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "lcf-cache")
+	}
+	return filepath.Join(dir, "lcf")
+}
+
+// New creates a Cache rooted at dir, or at DefaultDir if dir is empty
+func New(dir string) *Cache {
+	if dir == "" {
+		dir = DefaultDir()
+	}
+	return &Cache{Dir: dir}
+}
+
+// BlobPath returns where the package with the given digest is stored
+func (c *Cache) BlobPath(sum string) string {
+	return filepath.Join(c.Dir, "blobs", "sha256", strings.ToLower(sum))
+}
+
+// DownloadDir returns where packages are downloaded before they enter the cache
+func (c *Cache) DownloadDir() string {
+	return filepath.Join(c.Dir, "downloads")
+}
+
+// indexPath returns the metadata file of an integration version
+func (c *Cache) indexPath(integration, version string) string {
+	return filepath.Join(c.integrationDir(integration), safeName(version)+".json")
+}
+
+// integrationDir returns the index directory of an integration. Integration names are
+// matched case-insensitively, like the lockfile does, so "aws" finds packages cached as "AWS".
+func (c *Cache) integrationDir(integration string) string {
+	indexDir := filepath.Join(c.Dir, "index")
+	dir := filepath.Join(indexDir, safeName(integration))
+	if _, err := os.Stat(dir); err == nil {
+		return dir
+	}
+	dirs, err := os.ReadDir(indexDir)
+	if err != nil {
+		return dir
+	}
+	for _, d := range dirs {
+		if d.IsDir() && strings.EqualFold(d.Name(), safeName(integration)) {
+			return filepath.Join(indexDir, d.Name())
+		}
+	}
+	return dir
+}
+
+// safeName keeps names usable as single path elements
+func safeName(name string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(name)
+}
+
+// Lookup returns the cached package of an integration version and the path of its blob.
+// Integration names match case-insensitively. Entries whose blob has gone missing are misses.
+func (c *Cache) Lookup(integration, version string) (Entry, string, bool) {
+	entry, err := c.readEntry(c.indexPath(integration, version))
+	if err != nil {
+		return Entry{}, "", false
+	}
+
+	blob := c.BlobPath(entry.SHA256)
+	if _, err := os.Stat(blob); err != nil {
+		return Entry{}, "", false
+	}
+
+	// Remember the use for pruning, failures here are harmless
+	entry.LastUsed = time.Now().UTC()
+	_ = c.writeEntry(entry)
+
+	return entry, blob, true
+}
+
+// Resolve returns the highest cached version of an integration matching the constraint.
+// An empty constraint or "latest" picks the highest cached version.
+func (c *Cache) Resolve(integration, constraint string) (Entry, string, error) {
+	if constraint == "latest" {
+		constraint = ""
+	}
+	vc, err := semver.ParseConstraint(constraint)
+	if err != nil {
+		return Entry{}, "", err
+	}
+
+	entries, err := c.Versions(integration)
+	if err != nil {
+		return Entry{}, "", err
+	}
+	for i := len(entries) - 1; i >= 0; i-- {
+		v, err := semver.Parse(entries[i].Version)
+		if err != nil || !vc.Check(v) {
+			continue
+		}
+		if entry, blob, ok := c.Lookup(entries[i].Integration, entries[i].Version); ok {
+			return entry, blob, nil
+		}
+	}
+
+	if constraint == "" {
+		return Entry{}, "", fmt.Errorf("no cached package for %s", integration)
+	}
+	return Entry{}, "", fmt.Errorf("no cached package for %s matches %q", integration, constraint)
+}
+
+// Put copies a downloaded package into the cache and records it
+func (c *Cache) Put(entry Entry, srcPath string) (Entry, string, error) {
+	blobDir := filepath.Join(c.Dir, "blobs", "sha256")
+	if err := os.MkdirAll(blobDir, 0755); err != nil {
+		return Entry{}, "", fmt.Errorf("failed to create cache directory %s: %w", blobDir, err)
+	}
+
+	// Copy to a temporary file while hashing, then move it into place
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return Entry{}, "", fmt.Errorf("failed to open %s: %w", srcPath, err)
+	}
+	defer src.Close()
+
+	tmp, err := os.CreateTemp(blobDir, ".tmp-*")
+	if err != nil {
+		return Entry{}, "", fmt.Errorf("failed to create cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, h), src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return Entry{}, "", fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	sum := hex.EncodeToString(h.Sum(nil))
+	blob := c.BlobPath(sum)
+	if err := os.Rename(tmp.Name(), blob); err != nil {
+		return Entry{}, "", fmt.Errorf("failed to store package in cache: %w", err)
+	}
+
+	// Record the metadata
+	now := time.Now().UTC()
+	entry.SHA256 = sum
+	entry.Size = size
+	entry.Added = now
+	entry.LastUsed = now
+	if err := c.writeEntry(entry); err != nil {
+		return Entry{}, "", err
+	}
+
+	return entry, blob, nil
+}
+
+// List returns all cached packages sorted by integration and version
+func (c *Cache) List() ([]Entry, error) {
+	indexDir := filepath.Join(c.Dir, "index")
+	integrations, err := os.ReadDir(indexDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache index: %w", err)
+	}
+
+	var entries []Entry
+	for _, dir := range integrations {
+		if !dir.IsDir() {
+			continue
+		}
+		versions, err := c.Versions(dir.Name())
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, versions...)
+	}
+	return entries, nil
+}
+
+// Versions returns the cached packages of an integration, oldest version first
+func (c *Cache) Versions(integration string) ([]Entry, error) {
+	dir := c.integrationDir(integration)
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache index: %w", err)
+	}
+
+	var entries []Entry
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		entry, err := c.readEntry(filepath.Join(dir, file.Name()))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping cache entry %s: %v\n", file.Name(), err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sortEntries(entries)
+	return entries, nil
+}
+
+// Remove deletes the index entry of a package; its blob is removed by Prune once unreferenced
+func (c *Cache) Remove(entry Entry) error {
+	path := c.indexPath(entry.Integration, entry.Version)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove cache entry %s: %w", path, err)
+	}
+	return nil
+}
+
+// PruneOptions selects which cached packages to remove
+type PruneOptions struct {
+	OlderThan time.Duration // remove packages not used for this long (0 disables)
+	Keep      int           // keep only the newest N versions per integration (0 disables)
+}
+
+// staleDownloadAge is how long a file in downloads/ may go unmodified before Prune
+// removes it, unless OlderThan is shorter. Younger files may be a download in progress.
+const staleDownloadAge = 24 * time.Hour
+
+// Prune removes packages matching the options, then deletes blobs no entry refers to
+// and leftovers of interrupted downloads. It returns the removed entries.
+func (c *Cache) Prune(opts PruneOptions) ([]Entry, error) {
+	entries, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	// Group by integration, newest version last
+	byIntegration := make(map[string][]Entry)
+	for _, entry := range entries {
+		key := strings.ToLower(entry.Integration)
+		byIntegration[key] = append(byIntegration[key], entry)
+	}
+
+	var removed []Entry
+	cutoff := time.Now().Add(-opts.OlderThan)
+	for _, versions := range byIntegration {
+		for i, entry := range versions {
+			tooOld := opts.OlderThan > 0 && entry.LastUsed.Before(cutoff)
+			tooMany := opts.Keep > 0 && i < len(versions)-opts.Keep
+			_, blobErr := os.Stat(c.BlobPath(entry.SHA256))
+			if !tooOld && !tooMany && blobErr == nil {
+				continue
+			}
+			if err := c.Remove(entry); err != nil {
+				return removed, err
+			}
+			removed = append(removed, entry)
+		}
+	}
+
+	if err := c.removeUnreferencedBlobs(); err != nil {
+		return removed, err
+	}
+	maxAge := staleDownloadAge
+	if opts.OlderThan > 0 && opts.OlderThan < maxAge {
+		maxAge = opts.OlderThan
+	}
+	return removed, c.removeStaleDownloads(maxAge)
+}
+
+// removeStaleDownloads deletes files in downloads/ that were not modified for maxAge,
+// such as partial downloads that were never resumed
+func (c *Cache) removeStaleDownloads(maxAge time.Duration) error {
+	files, err := os.ReadDir(c.DownloadDir())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read cache downloads: %w", err)
+	}
+	cutoff := time.Now().Add(-maxAge)
+	for _, file := range files {
+		info, err := file.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		path := filepath.Join(c.DownloadDir(), file.Name())
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("failed to remove download %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// removeUnreferencedBlobs deletes blobs that no index entry points at
+func (c *Cache) removeUnreferencedBlobs() error {
+	entries, err := c.List()
+	if err != nil {
+		return err
+	}
+	referenced := make(map[string]bool)
+	for _, entry := range entries {
+		referenced[strings.ToLower(entry.SHA256)] = true
+	}
+
+	blobDir := filepath.Join(c.Dir, "blobs", "sha256")
+	blobs, err := os.ReadDir(blobDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read cache blobs: %w", err)
+	}
+	for _, blob := range blobs {
+		if referenced[blob.Name()] {
+			continue
+		}
+		if err := os.Remove(filepath.Join(blobDir, blob.Name())); err != nil {
+			return fmt.Errorf("failed to remove cache blob %s: %w", blob.Name(), err)
+		}
+	}
+	return nil
+}
+
+// Clear removes everything the cache stored. The directory itself may be shared with
+// other files (it is chosen by the user), so only the cache's own subdirectories are
+// deleted, and the directory only if that leaves it empty.
+func (c *Cache) Clear() error {
+	for _, sub := range []string{"blobs", "index", "downloads"} {
+		path := filepath.Join(c.Dir, sub)
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("failed to clear cache %s: %w", path, err)
+		}
+	}
+	// A directory with other files in it is kept
+	_ = os.Remove(c.Dir)
+	return nil
+}
+
+// readEntry loads an index file
+func (c *Cache) readEntry(path string) (Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Entry{}, err
+	}
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return Entry{}, fmt.Errorf("invalid cache entry %s: %w", path, err)
+	}
+	return entry, nil
+}
+
+// writeEntry saves an index file
+func (c *Cache) writeEntry(entry Entry) error {
+	path := c.indexPath(entry.Integration, entry.Version)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
+
+// sortEntries orders entries by integration name, then by ascending version
+func sortEntries(entries []Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Integration != entries[j].Integration {
+			return entries[i].Integration < entries[j].Integration
+		}
+		vi, errI := semver.Parse(entries[i].Version)
+		vj, errJ := semver.Parse(entries[j].Version)
+		if errI != nil || errJ != nil {
+			return entries[i].Version < entries[j].Version
+		}
+		return vi.Compare(vj) < 0
+	})
+}
@@ -0,0 +1,276 @@
+// File: pkg/cache/cache_test.go
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+// putPackage caches a package with the given content and returns its entry
+func putPackage(t *testing.T, c *Cache, integration, version, content string) Entry {
+	t.Helper()
+	src := filepath.Join(t.TempDir(), integration+"_"+version+".ssi.zip")
+	if err := os.WriteFile(src, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	entry, _, err := c.Put(Entry{Integration: integration, Version: version, File: filepath.Base(src)}, src)
+	if err != nil {
+		t.Fatalf("Put(%s %s): %v", integration, version, err)
+	}
+	return entry
+}
+
+func TestPut(t *testing.T) {
+	c := New(t.TempDir())
+	entry := putPackage(t, c, "AWS", "1.1.118", "package bytes")
+
+	sum := sha256.Sum256([]byte("package bytes"))
+	if entry.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("SHA256 = %s, want %x", entry.SHA256, sum)
+	}
+	if entry.Size != int64(len("package bytes")) || entry.Added.IsZero() || entry.LastUsed.IsZero() {
+		t.Errorf("Put recorded %+v", entry)
+	}
+
+	got, blob, ok := c.Lookup("AWS", "1.1.118")
+	if !ok {
+		t.Fatal("Lookup after Put missed")
+	}
+	if blob != c.BlobPath(entry.SHA256) || got.File != "AWS_1.1.118.ssi.zip" {
+		t.Errorf("Lookup = %+v, %s", got, blob)
+	}
+	data, err := os.ReadFile(blob)
+	if err != nil || string(data) != "package bytes" {
+		t.Errorf("blob content = %q, %v", data, err)
+	}
+
+	// Packages with the same content share a blob
+	other := putPackage(t, c, "AWS", "1.1.119", "package bytes")
+	if other.SHA256 != entry.SHA256 {
+		t.Errorf("identical packages got different digests")
+	}
+	blobs, _ := os.ReadDir(filepath.Join(c.Dir, "blobs", "sha256"))
+	if len(blobs) != 1 {
+		t.Errorf("%d blobs stored, want 1", len(blobs))
+	}
+
+	// A missing blob makes the entry a miss
+	os.Remove(blob)
+	if _, _, ok := c.Lookup("AWS", "1.1.118"); ok {
+		t.Error("Lookup should miss when the blob is gone")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	c := New(t.TempDir())
+	for _, v := range []string{"1.1.117", "1.1.118", "1.2.0", "2.0.0-beta.2", "2.0.0-beta.10"} {
+		putPackage(t, c, "AWS", v, "AWS "+v)
+	}
+
+	tests := []struct {
+		constraint string
+		want       string // empty if nothing should match
+	}{
+		{"", "1.2.0"},
+		{"latest", "1.2.0"},
+		{"~1.1.0", "1.1.118"},
+		{"1.1.117", "1.1.117"},
+		{"^1.0.0", "1.2.0"},
+		{">=2.0.0-beta.1", "2.0.0-beta.10"},
+		{"^3.0.0", ""},
+	}
+	for _, tt := range tests {
+		entry, blob, err := c.Resolve("AWS", tt.constraint)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("Resolve(%q) = %s, want an error", tt.constraint, entry.Version)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Resolve(%q): %v", tt.constraint, err)
+			continue
+		}
+		if entry.Version != tt.want || blob != c.BlobPath(entry.SHA256) {
+			t.Errorf("Resolve(%q) = %s, want %s", tt.constraint, entry.Version, tt.want)
+		}
+	}
+
+	if _, _, err := c.Resolve("Slack", ""); err == nil {
+		t.Error("Resolve of an uncached integration should fail")
+	}
+	if _, _, err := c.Resolve("AWS", ">=abc"); err == nil {
+		t.Error("Resolve with an invalid constraint should fail")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		name string
+		opts PruneOptions
+		keep []string // versions left in the cache
+	}{
+		{name: "nothing", opts: PruneOptions{}, keep: []string{"1.0.0", "1.1.0", "1.2.0"}},
+		{name: "keep newest", opts: PruneOptions{Keep: 2}, keep: []string{"1.1.0", "1.2.0"}},
+		{name: "older than", opts: PruneOptions{OlderThan: 24 * time.Hour}, keep: []string{"1.1.0", "1.2.0"}},
+		{name: "both", opts: PruneOptions{Keep: 1, OlderThan: 24 * time.Hour}, keep: []string{"1.2.0"}},
+	}
+	for _, tt := range tests {
+		c := New(t.TempDir())
+		for _, v := range []string{"1.0.0", "1.1.0", "1.2.0"} {
+			putPackage(t, c, "AWS", v, "AWS "+v)
+		}
+
+		// 1.0.0 was last used a week ago
+		old, _, _ := c.Lookup("AWS", "1.0.0")
+		old.LastUsed = time.Now().Add(-7 * 24 * time.Hour)
+		if err := c.writeEntry(old); err != nil {
+			t.Fatal(err)
+		}
+
+		removed, err := c.Prune(tt.opts)
+		if err != nil {
+			t.Fatalf("%s: Prune: %v", tt.name, err)
+		}
+		entries, _ := c.List()
+		var kept []string
+		for _, e := range entries {
+			kept = append(kept, e.Version)
+		}
+		if !equal(kept, tt.keep) {
+			t.Errorf("%s: kept %v, want %v", tt.name, kept, tt.keep)
+		}
+		if len(removed)+len(kept) != 3 {
+			t.Errorf("%s: removed %d entries, kept %d", tt.name, len(removed), len(kept))
+		}
+
+		// Blobs of removed entries are deleted
+		blobs, _ := os.ReadDir(filepath.Join(c.Dir, "blobs", "sha256"))
+		if len(blobs) != len(tt.keep) {
+			t.Errorf("%s: %d blobs left, want %d", tt.name, len(blobs), len(tt.keep))
+		}
+	}
+}
+
+func TestPruneDanglingEntries(t *testing.T) {
+	c := New(t.TempDir())
+	entry := putPackage(t, c, "AWS", "1.0.0", "AWS 1.0.0")
+	os.Remove(c.BlobPath(entry.SHA256))
+
+	removed, err := c.Prune(PruneOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 1 || removed[0].Version != "1.0.0" {
+		t.Errorf("Prune removed %+v, want the entry without a blob", removed)
+	}
+}
+
+func TestLookupIgnoresCase(t *testing.T) {
+	c := New(t.TempDir())
+	putPackage(t, c, "AWS", "1.0.0", "AWS 1.0.0")
+
+	if _, _, ok := c.Lookup("aws", "1.0.0"); !ok {
+		t.Error("Lookup(aws) missed the package cached as AWS")
+	}
+	if entry, _, err := c.Resolve("Aws", "^1.0.0"); err != nil || entry.Version != "1.0.0" {
+		t.Errorf("Resolve(Aws) = %+v, %v", entry, err)
+	}
+
+	// Later versions join the existing index directory instead of starting a second one
+	putPackage(t, c, "aws", "1.1.0", "AWS 1.1.0")
+	dirs, _ := os.ReadDir(filepath.Join(c.Dir, "index"))
+	if len(dirs) != 1 || dirs[0].Name() != "AWS" {
+		t.Errorf("index has %d integration directories, want only AWS", len(dirs))
+	}
+	removed, err := c.Prune(PruneOptions{Keep: 1})
+	if err != nil || len(removed) != 1 || removed[0].Version != "1.0.0" {
+		t.Errorf("Prune(Keep: 1) removed %+v, %v, want 1.0.0", removed, err)
+	}
+}
+
+func TestPruneDownloads(t *testing.T) {
+	c := New(t.TempDir())
+	if err := os.MkdirAll(c.DownloadDir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * staleDownloadAge)
+	for _, name := range []string{"AWS_1.0.0.ssi.zip.part", "AWS_1.0.0.ssi.zip.part.validator", "Slack_2.0.0.ssi.zip", "fresh.ssi.zip.part"} {
+		path := filepath.Join(c.DownloadDir(), name)
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if name != "fresh.ssi.zip.part" {
+			os.Chtimes(path, old, old)
+		}
+	}
+
+	if _, err := c.Prune(PruneOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	// A recent partial file may belong to a download in progress
+	left, _ := os.ReadDir(c.DownloadDir())
+	if len(left) != 1 || left[0].Name() != "fresh.ssi.zip.part" {
+		t.Errorf("Prune left %d downloads, want only fresh.ssi.zip.part", len(left))
+	}
+
+	if _, err := c.Prune(PruneOptions{OlderThan: time.Nanosecond}); err != nil {
+		t.Fatal(err)
+	}
+	if left, _ := os.ReadDir(c.DownloadDir()); len(left) != 0 {
+		t.Errorf("Prune(OlderThan) left %d downloads", len(left))
+	}
+}
+
+func TestClearKeepsForeignFiles(t *testing.T) {
+	dir := t.TempDir()
+	c := New(dir)
+	putPackage(t, c, "AWS", "1.0.0", "AWS 1.0.0")
+	if err := os.MkdirAll(c.DownloadDir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("mine"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	left, _ := os.ReadDir(dir)
+	var names []string
+	for _, e := range left {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	if !equal(names, []string{"notes.txt"}) {
+		t.Errorf("Clear left %v, want only notes.txt", names)
+	}
+
+	// A cache directory holding nothing else is removed
+	os.Remove(filepath.Join(dir, "notes.txt"))
+	putPackage(t, c, "AWS", "1.0.0", "AWS 1.0.0")
+	if err := c.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("empty cache directory was kept: %v", err)
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,189 @@
+// File: pkg/config/config.go
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+)
+
+// Environment variables understood by lcf
+const (
+	EnvConfig       = "LCF_CONFIG"        // path to the config file
+	EnvBaseURL      = "LCF_BASE_URL"      // Pliant server base URL
+	EnvDetailsPath  = "LCF_DETAILS_PATH"  // details endpoint path template
+	EnvDownloadPath = "LCF_DOWNLOAD_PATH" // download endpoint path template
+	EnvToken        = "LCF_TOKEN"         // bearer token
+	EnvAPIKey       = "LCF_API_KEY"       // API key
+	EnvCacheDir     = "LCF_CACHE_DIR"     // package cache directory
+	EnvPublicKey    = "LCF_PUBLIC_KEY"    // public key for package signatures
+)
+
+// Config holds the settings read from the lcf config file
+type Config struct {
+	BaseURL   string    `json:"base_url"`  // e.g. "https://pliant.example.com"
+	Endpoints Endpoints `json:"endpoints"` // per-endpoint path templates
+	Auth      Auth      `json:"auth"`      // credentials for the Pliant server
+	CacheDir  string    `json:"cache_dir"` // package cache directory (default ~/.cache/lcf)
+	Verify    Verify    `json:"verify"`    // package integrity settings
+	HTTP      HTTP      `json:"http"`      // timeouts and retries
+}
+
+// HTTP holds network settings; durations use Go syntax (e.g. "30s", "10m")
+type HTTP struct {
+	Timeout         string `json:"timeout"`          // API calls and response headers (default 30s)
+	DownloadTimeout string `json:"download_timeout"` // per download attempt (default none)
+	Retries         *int   `json:"retries"`          // retries after the first attempt (default 3)
+	RetryBackoff    string `json:"retry_backoff"`    // delay before the first retry (default 1s)
+}
+
+// Endpoints holds the path templates for each Pliant API endpoint
+type Endpoints struct {
+	Details   string `json:"details"`   // e.g. "/api/getIntegrationDetails?Name={name}"
+	Download  string `json:"download"`  // e.g. "/files/files/{file}"
+	List      string `json:"list"`      // e.g. "/api/getIntegrations"
+	Search    string `json:"search"`    // optional, e.g. "/api/searchIntegrations?q={query}"
+	Checksum  string `json:"checksum"`  // optional, e.g. "/files/files/{file}.sha256"
+	Signature string `json:"signature"` // optional, e.g. "/files/files/{file}.sig"
+}
+
+// Verify holds package integrity settings
+type Verify struct {
+	PublicKey string `json:"public_key"` // PEM public key; when set, packages must carry a valid signature
+}
+
+// Auth describes how to authenticate against the Pliant server
+type Auth struct {
+	Type      string `json:"type"`       // "bearer", "api_key" or "basic"
+	Token     string `json:"token"`      // bearer token or API key
+	TokenFile string `json:"token_file"` // file containing the token
+	TokenEnv  string `json:"token_env"`  // environment variable containing the token
+	Header    string `json:"header"`     // header to send the key or token in (default X-API-Key for keys, Authorization: Bearer for tokens)
+	Username  string `json:"username"`   // basic auth user
+	Password  string `json:"password"`   // basic auth password
+}
+
+// Credentials converts the auth settings into fetcher credentials.
+// It returns nil if no authentication is configured.
+func (a Auth) Credentials() (fetcher.Credentials, error) {
+	authType := a.Type
+	if authType == "" {
+		// Infer the type from the fields that are set
+		switch {
+		case a.Username != "":
+			authType = "basic"
+		case a.Header != "":
+			authType = "api_key"
+		case a.Token != "" || a.TokenFile != "" || a.TokenEnv != "":
+			authType = "bearer"
+		default:
+			return nil, nil
+		}
+	}
+
+	// Header to send the token in, empty for "Authorization: Bearer"
+	header := ""
+	switch authType {
+	case "bearer":
+		header = a.Header
+	case "api_key":
+		header = a.Header
+		if header == "" {
+			header = fetcher.DefaultAPIKeyHeader
+		}
+	case "basic":
+		if a.Username == "" {
+			return nil, fmt.Errorf("basic auth requires a username")
+		}
+		return fetcher.BasicAuth{Username: a.Username, Password: a.Password}, nil
+	default:
+		return nil, fmt.Errorf("unsupported auth type %q (expected bearer, api_key or basic)", a.Type)
+	}
+
+	// Resolve where the token comes from
+	switch {
+	case a.Token != "":
+		if header != "" {
+			return fetcher.APIKey{Header: header, Key: a.Token}, nil
+		}
+		return fetcher.BearerToken(a.Token), nil
+	case a.TokenFile != "":
+		return fetcher.TokenFile{Path: a.TokenFile, Header: header}, nil
+	case a.TokenEnv != "":
+		return fetcher.EnvToken{Var: a.TokenEnv, Header: header}, nil
+	default:
+		return nil, fmt.Errorf("%s auth requires token, token_file or token_env", authType)
+	}
+}
+
+// DefaultPath returns the default config file location (e.g. ~/.config/lcf/config.json)
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "lcf", "config.json")
+}
+
+// Load reads the config file at path and applies environment overrides.
+// An empty path falls back to $LCF_CONFIG and then to DefaultPath; a missing
+// default file is not an error, a missing explicit file is.
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+
+	explicit := path != ""
+	if !explicit {
+		path = os.Getenv(EnvConfig)
+		explicit = path != ""
+	}
+	if !explicit {
+		path = DefaultPath()
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(data, cfg); err != nil {
+				return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+			}
+		case errors.Is(err, os.ErrNotExist) && !explicit:
+			// No config file, use defaults
+		default:
+			return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+		}
+	}
+
+	cfg.applyEnv()
+	return cfg, nil
+}
+
+// applyEnv overrides config values with environment variables
+func (c *Config) applyEnv() {
+	if v := os.Getenv(EnvBaseURL); v != "" {
+		c.BaseURL = v
+	}
+	if v := os.Getenv(EnvDetailsPath); v != "" {
+		c.Endpoints.Details = v
+	}
+	if v := os.Getenv(EnvDownloadPath); v != "" {
+		c.Endpoints.Download = v
+	}
+	if v := os.Getenv(EnvPublicKey); v != "" {
+		c.Verify.PublicKey = v
+	}
+	if v := os.Getenv(EnvCacheDir); v != "" {
+		c.CacheDir = v
+	}
+	if v := os.Getenv(EnvToken); v != "" {
+		c.Auth = Auth{Type: "bearer", Token: v}
+	}
+	if v := os.Getenv(EnvAPIKey); v != "" {
+		c.Auth = Auth{Type: "api_key", Token: v, Header: c.Auth.Header}
+	}
+}
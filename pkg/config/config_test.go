@@ -0,0 +1,57 @@
+// File: pkg/config/config_test.go
+
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+)
+
+func TestAuthCredentials(t *testing.T) {
+	tests := []struct {
+		name string
+		auth Auth
+		want fetcher.Credentials
+	}{
+		{"none", Auth{}, nil},
+		{"bearer", Auth{Type: "bearer", Token: "t"}, fetcher.BearerToken("t")},
+		{"inferred bearer", Auth{Token: "t"}, fetcher.BearerToken("t")},
+		// --token with --api-key-header sends the token in that header
+		{"bearer in header", Auth{Type: "bearer", Token: "t", Header: "X-Pliant-Token"}, fetcher.APIKey{Header: "X-Pliant-Token", Key: "t"}},
+		{"api key", Auth{Type: "api_key", Token: "k"}, fetcher.APIKey{Header: fetcher.DefaultAPIKeyHeader, Key: "k"}},
+		{"inferred api key", Auth{Token: "k", Header: "X-Key"}, fetcher.APIKey{Header: "X-Key", Key: "k"}},
+		{"token file", Auth{TokenFile: "/run/token"}, fetcher.TokenFile{Path: "/run/token"}},
+		{"token file header", Auth{Type: "api_key", TokenFile: "/run/key"}, fetcher.TokenFile{Path: "/run/key", Header: fetcher.DefaultAPIKeyHeader}},
+		{"env token", Auth{TokenEnv: "PLIANT_TOKEN"}, fetcher.EnvToken{Var: "PLIANT_TOKEN"}},
+		{"basic", Auth{Username: "u", Password: "p"}, fetcher.BasicAuth{Username: "u", Password: "p"}},
+	}
+	for _, tt := range tests {
+		got, err := tt.auth.Credentials()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Credentials() = %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAuthCredentialsErrors(t *testing.T) {
+	tests := []struct {
+		auth Auth
+		want string
+	}{
+		{Auth{Type: "oauth", Token: "t"}, "unsupported auth type"},
+		{Auth{Type: "basic"}, "requires a username"},
+		{Auth{Type: "bearer"}, "requires token, token_file or token_env"},
+	}
+	for _, tt := range tests {
+		if _, err := tt.auth.Credentials(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%+v: error %v, want %q", tt.auth, err, tt.want)
+		}
+	}
+}
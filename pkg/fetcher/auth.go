@@ -0,0 +1,119 @@
+// File: pkg/fetcher/auth.go
+
+package fetcher
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// DefaultAPIKeyHeader is the header used for API keys when none is configured
+const DefaultAPIKeyHeader = "X-API-Key"
+
+// Credentials applies authentication to an outgoing request
+type Credentials interface {
+	Apply(req *http.Request) error
+}
+
+// BearerToken sends a static token as "Authorization: Bearer <token>"
+type BearerToken string
+
+// Apply sets the Authorization header
+func (t BearerToken) Apply(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(t))
+	return nil
+}
+
+// APIKey sends a static key in a custom header
+type APIKey struct {
+	Header string // defaults to DefaultAPIKeyHeader
+	Key    string
+}
+
+// Apply sets the API key header
+func (k APIKey) Apply(req *http.Request) error {
+	header := k.Header
+	if header == "" {
+		header = DefaultAPIKeyHeader
+	}
+	req.Header.Set(header, k.Key)
+	return nil
+}
+
+// BasicAuth sends HTTP basic authentication
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Apply sets the basic auth header
+func (b BasicAuth) Apply(req *http.Request) error {
+	req.SetBasicAuth(b.Username, b.Password)
+	return nil
+}
+
+// TokenFile reads a token from a file on every request, so rotated tokens are picked up.
+// The token is sent as a bearer token unless Header is set.
+type TokenFile struct {
+	Path   string
+	Header string // optional API key header to send the token in
+}
+
+// Apply reads the token file and applies the token
+func (f TokenFile) Apply(req *http.Request) error {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return fmt.Errorf("token file %s is empty", f.Path)
+	}
+	return applyToken(req, token, f.Header)
+}
+
+// EnvToken reads a token from an environment variable.
+// The token is sent as a bearer token unless Header is set.
+type EnvToken struct {
+	Var    string
+	Header string // optional API key header to send the token in
+}
+
+// Apply reads the environment variable and applies the token
+func (e EnvToken) Apply(req *http.Request) error {
+	token := os.Getenv(e.Var)
+	if token == "" {
+		return fmt.Errorf("environment variable %s is not set", e.Var)
+	}
+	return applyToken(req, token, e.Header)
+}
+
+// applyToken sends a token as a bearer token or in a custom header
+func applyToken(req *http.Request, token, header string) error {
+	if header != "" {
+		return APIKey{Header: header, Key: token}.Apply(req)
+	}
+	return BearerToken(token).Apply(req)
+}
+
+// AuthError is returned when the server rejects a request with 401 or 403
+type AuthError struct {
+	URL           string // the rejected request URL
+	StatusCode    int
+	Authenticated bool // whether credentials were sent
+}
+
+// Error describes the failure and how to fix it
+func (e *AuthError) Error() string {
+	status := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	switch {
+	case !e.Authenticated:
+		return fmt.Sprintf("%s: the server requires credentials but none are configured", status)
+	case e.StatusCode == http.StatusForbidden:
+		return fmt.Sprintf("%s: the configured credentials are not allowed to access this resource", status)
+	default:
+		return fmt.Sprintf("%s: the server rejected the configured credentials", status)
+	}
+}
@@ -0,0 +1,173 @@
+// File: pkg/fetcher/auth_test.go
+
+package fetcher
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCredentialsApply(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("  from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("LCF_TEST_TOKEN", "from-env")
+
+	tests := []struct {
+		name   string
+		creds  Credentials
+		header string
+		want   string
+	}{
+		{"bearer", BearerToken("secret"), "Authorization", "Bearer secret"},
+		{"api key", APIKey{Key: "secret"}, DefaultAPIKeyHeader, "secret"},
+		{"api key header", APIKey{Header: "X-Pliant-Key", Key: "secret"}, "X-Pliant-Key", "secret"},
+		{"basic", BasicAuth{Username: "user", Password: "pass"}, "Authorization", "Basic dXNlcjpwYXNz"},
+		{"token file", TokenFile{Path: tokenFile}, "Authorization", "Bearer from-file"},
+		{"token file header", TokenFile{Path: tokenFile, Header: "X-Token"}, "X-Token", "from-file"},
+		{"env token", EnvToken{Var: "LCF_TEST_TOKEN"}, "Authorization", "Bearer from-env"},
+		{"env token header", EnvToken{Var: "LCF_TEST_TOKEN", Header: "X-Token"}, "X-Token", "from-env"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "https://pliant.example.com/", nil)
+		if err := tt.creds.Apply(req); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := req.Header.Get(tt.header); got != tt.want {
+			t.Errorf("%s: %s = %q, want %q", tt.name, tt.header, got, tt.want)
+		}
+		// A token in a custom header is sent as is, without a bearer header besides it
+		if tt.header != "Authorization" && req.Header.Get("Authorization") != "" {
+			t.Errorf("%s: unexpected Authorization header %q", tt.name, req.Header.Get("Authorization"))
+		}
+	}
+}
+
+func TestTokenFileRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	creds := TokenFile{Path: path}
+	for _, token := range []string{"first", "second"} {
+		if err := os.WriteFile(path, []byte(token), 0600); err != nil {
+			t.Fatal(err)
+		}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if err := creds.Apply(req); err != nil || req.Header.Get("Authorization") != "Bearer "+token {
+			t.Errorf("Apply = %q, %v, want the token %s", req.Header.Get("Authorization"), err, token)
+		}
+	}
+}
+
+func TestCredentialsApplyErrors(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty")
+	if err := os.WriteFile(empty, []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("LCF_TEST_UNSET", "")
+
+	tests := []struct {
+		creds Credentials
+		want  string
+	}{
+		{TokenFile{Path: filepath.Join(dir, "missing")}, "failed to read token file"},
+		{TokenFile{Path: empty}, "is empty"},
+		{EnvToken{Var: "LCF_TEST_UNSET"}, "LCF_TEST_UNSET is not set"},
+	}
+	for _, tt := range tests {
+		err := tt.creds.Apply(httptest.NewRequest(http.MethodGet, "/", nil))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%#v: error %v, want %q", tt.creds, err, tt.want)
+		}
+	}
+}
+
+func TestAuthError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		creds  Credentials
+		want   string
+	}{
+		{"no credentials", http.StatusUnauthorized, nil, "none are configured"},
+		{"rejected", http.StatusUnauthorized, BearerToken("wrong"), "rejected the configured credentials"},
+		{"forbidden", http.StatusForbidden, BearerToken("limited"), "not allowed to access"},
+	}
+	for _, tt := range tests {
+		requests := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(tt.status)
+		}))
+		c := NewClient(Options{BaseURL: srv.URL, Credentials: tt.creds})
+		_, err := c.ListIntegrations()
+		srv.Close()
+
+		var authErr *AuthError
+		if !errors.As(err, &authErr) {
+			t.Errorf("%s: error %v, want an *AuthError", tt.name, err)
+			continue
+		}
+		if authErr.StatusCode != tt.status || authErr.Authenticated != (tt.creds != nil) || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: %+v (%v), want status %d and %q", tt.name, authErr, err, tt.status, tt.want)
+		}
+		// Authentication failures are not retried
+		if requests != 1 {
+			t.Errorf("%s: %d requests, want 1", tt.name, requests)
+		}
+	}
+}
+
+// headerRecorder remembers the Authorization header of every request by path
+type headerRecorder struct {
+	mu      sync.Mutex
+	headers map[string]string
+}
+
+func (h *headerRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	if h.headers == nil {
+		h.headers = make(map[string]string)
+	}
+	h.headers[r.URL.Path] = r.Header.Get("Authorization")
+	h.mu.Unlock()
+	w.Write([]byte("0000000000000000000000000000000000000000000000000000000000000000"))
+}
+
+func TestCredentialsOnlyForBaseHost(t *testing.T) {
+	base := &headerRecorder{}
+	baseSrv := httptest.NewServer(base)
+	defer baseSrv.Close()
+	other := &headerRecorder{}
+	otherSrv := httptest.NewServer(other)
+	defer otherSrv.Close()
+
+	c := NewClient(Options{
+		BaseURL:       baseSrv.URL,
+		ChecksumPath:  "/files/{file}.sha256",
+		SignaturePath: otherSrv.URL + "/sigs/{file}.sig",
+		Credentials:   BearerToken("secret"),
+		Retries:       -1,
+	})
+	def := c.newIntegrationDef("AWS", "1.1.118", "AWS_1.1.118.ssi.zip")
+	if _, err := c.FetchChecksum(def); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.FetchSignature(def); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := base.headers["/files/AWS_1.1.118.ssi.zip.sha256"]; got != "Bearer secret" {
+		t.Errorf("base host got Authorization %q, want the token", got)
+	}
+	if got, ok := other.headers["/sigs/AWS_1.1.118.ssi.zip.sig"]; !ok || got != "" {
+		t.Errorf("other host got Authorization %q (requested %v), want none", got, ok)
+	}
+}
@@ -0,0 +1,231 @@
+// File: pkg/fetcher/client.go
+
+package fetcher
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the public automation-library host
+	DefaultBaseURL = "https://automation-library.ibm.com"
+	// DefaultDetailsPath is the path template of the integration details endpoint
+	DefaultDetailsPath = "/api/getIntegrationDetails?Name={name}"
+	// DefaultDownloadPath is the path template of the package download endpoint
+	DefaultDownloadPath = "/files/files/{file}"
+	// DefaultListPath is the path template of the integration list endpoint
+	DefaultListPath = "/api/getIntegrations"
+
+	// DefaultTimeout bounds API requests and waiting for response headers
+	DefaultTimeout = 30 * time.Second
+	// DefaultRetries is how often failed requests are retried
+	DefaultRetries = 3
+	// DefaultRetryBackoff is the delay before the first retry, doubled for every further one
+	DefaultRetryBackoff = time.Second
+	// maxRetryBackoff caps the exponential backoff
+	maxRetryBackoff = 30 * time.Second
+)
+
+// Options configures which Pliant server a Client talks to.
+//
+// Path templates are appended to BaseURL and may contain placeholders:
+//   - {name} the integration name (e.g. "AWS")
+//   - {file} the package file name (e.g. "AWS_1.1.118.ssi.zip")
+//   - {query} the search text (search endpoint only)
+//
+// Templates may also be absolute URLs. Credentials are only sent to the scheme and host
+// of BaseURL, never to other servers.
+//
+// The checksum, signature and search endpoints are optional and disabled when empty;
+// without a search endpoint, searches filter the integration list locally. List and search
+// responses may be paged, each page linking to the next in a "next" field (resolved against the page URL).
+//
+// Placeholders before the "?" are path-escaped, placeholders in the query are query-escaped.
+//
+// Network errors, 5xx and 429 responses are retried with exponential backoff.
+// Zero Timeout, Retries and RetryBackoff use the defaults, a negative Retries disables retries.
+type Options struct {
+	BaseURL       string      // e.g. "https://pliant.example.com"
+	DetailsPath   string      // e.g. "/api/getIntegrationDetails?Name={name}"
+	DownloadPath  string      // e.g. "/files/files/{file}"
+	ListPath      string      // e.g. "/api/getIntegrations"
+	SearchPath    string      // e.g. "/api/searchIntegrations?q={query}"
+	ChecksumPath  string      // e.g. "/files/files/{file}.sha256"
+	SignaturePath string      // e.g. "/files/files/{file}.sig"
+	Credentials   Credentials // optional, applied to requests to the BaseURL host
+
+	Timeout         time.Duration // per-request limit for API calls, and for response headers on downloads
+	DownloadTimeout time.Duration // per-attempt limit for package downloads (0 means none)
+	Retries         int           // retries after the first attempt
+	RetryBackoff    time.Duration // delay before the first retry
+}
+
+// Client talks to a Pliant server
+type Client struct {
+	opts     Options
+	http     *http.Client // for API calls
+	download *http.Client // for package downloads
+}
+
+// NewClient creates a Client, filling unset options with the public defaults
+func NewClient(opts Options) *Client {
+	if opts.BaseURL == "" {
+		opts.BaseURL = DefaultBaseURL
+	}
+	if opts.DetailsPath == "" {
+		opts.DetailsPath = DefaultDetailsPath
+	}
+	if opts.DownloadPath == "" {
+		opts.DownloadPath = DefaultDownloadPath
+	}
+	if opts.ListPath == "" {
+		opts.ListPath = DefaultListPath
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.Retries == 0 {
+		opts.Retries = DefaultRetries
+	}
+	if opts.Retries < 0 {
+		opts.Retries = 0
+	}
+	if opts.RetryBackoff == 0 {
+		opts.RetryBackoff = DefaultRetryBackoff
+	}
+	opts.BaseURL = strings.TrimRight(opts.BaseURL, "/")
+
+	// Bound connecting and waiting for headers, but let large bodies take their time
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: opts.Timeout, KeepAlive: 30 * time.Second}).DialContext,
+		TLSHandshakeTimeout:   opts.Timeout,
+		ResponseHeaderTimeout: opts.Timeout,
+		IdleConnTimeout:       90 * time.Second,
+	}
+
+	return &Client{
+		opts:     opts,
+		http:     &http.Client{Transport: transport, Timeout: opts.Timeout},
+		download: &http.Client{Transport: transport, Timeout: opts.DownloadTimeout},
+	}
+}
+
+// get performs an authenticated GET request, retrying transient failures.
+// 401 and 403 responses are turned into an *AuthError.
+func (c *Client) get(rawURL string) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, transient, err := c.send(c.http, rawURL, nil)
+		if err == nil && !retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		if !transient || attempt > c.opts.Retries {
+			// Let the caller report the final status
+			return resp, err
+		}
+
+		if err == nil {
+			err = fmt.Errorf("server responded %s", resp.Status)
+			resp.Body.Close()
+		}
+		c.wait(attempt, err)
+	}
+}
+
+// send performs a single authenticated GET request. transient reports
+// whether a failure is worth retrying (network errors and 5xx/429 responses).
+func (c *Client) send(client *http.Client, rawURL string, header http.Header) (resp *http.Response, transient bool, err error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid request URL %s: %w", rawURL, err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	// Apply credentials if configured, but don't leak them to other hosts
+	authenticated := c.opts.Credentials != nil && c.isBaseHost(req.URL)
+	if authenticated {
+		if err := c.opts.Credentials.Apply(req); err != nil {
+			return nil, false, fmt.Errorf("failed to apply credentials: %w", err)
+		}
+	}
+
+	resp, err = client.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+
+	// Report authentication failures clearly instead of dumping the body
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		resp.Body.Close()
+		return nil, false, &AuthError{
+			URL:           rawURL,
+			StatusCode:    resp.StatusCode,
+			Authenticated: authenticated,
+		}
+	}
+
+	return resp, retryableStatus(resp.StatusCode), nil
+}
+
+// isBaseHost reports whether u points to the scheme and host of BaseURL
+func (c *Client) isBaseHost(u *url.URL) bool {
+	base, err := url.Parse(c.opts.BaseURL)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// retryableStatus reports whether a response status indicates a transient failure
+func retryableStatus(status int) bool {
+	return status >= 500 || status == http.StatusTooManyRequests
+}
+
+// wait sleeps before the given retry, doubling the backoff each time (with jitter)
+func (c *Client) wait(attempt int, reason error) {
+	delay := c.opts.RetryBackoff << (attempt - 1)
+	if delay > maxRetryBackoff || delay <= 0 {
+		delay = maxRetryBackoff
+	}
+	delay += time.Duration(rand.Int63n(int64(delay)/4 + 1))
+
+	fmt.Fprintf(os.Stderr, "Retrying in %s (attempt %d/%d): %v\n", delay.Round(time.Millisecond), attempt, c.opts.Retries, reason)
+	time.Sleep(delay)
+}
+
+// endpointURL expands a path template and joins it with the base URL
+func (c *Client) endpointURL(pathTmpl string, vars map[string]string) string {
+	// Escape path and query placeholders differently
+	path, query, hasQuery := strings.Cut(pathTmpl, "?")
+	path = expandTemplate(path, vars, url.PathEscape)
+	if hasQuery {
+		path += "?" + expandTemplate(query, vars, url.QueryEscape)
+	}
+
+	// Templates may also be absolute URLs
+	if strings.Contains(path, "://") {
+		return path
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return c.opts.BaseURL + path
+}
+
+// expandTemplate replaces {key} placeholders with escaped values
+func expandTemplate(tmpl string, vars map[string]string, escape func(string) string) string {
+	pairs := make([]string, 0, len(vars)*2)
+	for key, value := range vars {
+		pairs = append(pairs, "{"+key+"}", escape(value))
+	}
+	return strings.NewReplacer(pairs...).Replace(tmpl)
+}
@@ -0,0 +1,79 @@
+// File: pkg/fetcher/client_test.go
+
+package fetcher
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestEndpointURL(t *testing.T) {
+	c := NewClient(Options{BaseURL: "https://pliant.example.com/base/"})
+	tests := []struct {
+		tmpl string
+		vars map[string]string
+		want string
+	}{
+		{DefaultDetailsPath, map[string]string{"name": "AWS"}, "https://pliant.example.com/base/api/getIntegrationDetails?Name=AWS"},
+		{DefaultDownloadPath, map[string]string{"file": "AWS_1.1.118.ssi.zip"}, "https://pliant.example.com/base/files/files/AWS_1.1.118.ssi.zip"},
+		{"api/list", nil, "https://pliant.example.com/base/api/list"},
+		// Path placeholders are path-escaped, query placeholders query-escaped
+		{"/packages/{name}/{file}", map[string]string{"name": "My Integration", "file": "a/b?.zip"}, "https://pliant.example.com/base/packages/My%20Integration/a%2Fb%3F.zip"},
+		{"/search?q={query}&name={name}", map[string]string{"query": "s3 & ec2", "name": "a/b"}, "https://pliant.example.com/base/search?q=s3+%26+ec2&name=a%2Fb"},
+		{"/{name}/search?q={name}", map[string]string{"name": "a b"}, "https://pliant.example.com/base/a%20b/search?q=a+b"},
+		// Unknown placeholders are left alone
+		{"/files/{file}", map[string]string{"name": "AWS"}, "https://pliant.example.com/base/files/{file}"},
+		// Absolute templates ignore the base URL
+		{"https://cdn.example.com/{file}.sig", map[string]string{"file": "AWS_1.1.118.ssi.zip"}, "https://cdn.example.com/AWS_1.1.118.ssi.zip.sig"},
+	}
+	for _, tt := range tests {
+		if got := c.endpointURL(tt.tmpl, tt.vars); got != tt.want {
+			t.Errorf("endpointURL(%q, %v) = %s, want %s", tt.tmpl, tt.vars, got, tt.want)
+		}
+	}
+}
+
+func TestCustomServer(t *testing.T) {
+	// A stand-in Pliant server below a path prefix, with its own endpoint layout
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pliant/details", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("integration") != "AWS" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"result": map[string]interface{}{
+			"Name": "AWS", "LatestVersion": "AWS_1.1.118.ssi.zip",
+		}})
+	})
+	mux.HandleFunc("/pliant/packages/AWS/AWS_1.1.118.ssi.zip", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(packageBody))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := NewClient(Options{
+		BaseURL:      srv.URL + "/pliant/",
+		DetailsPath:  "/details?integration={name}",
+		DownloadPath: "/packages/{name}/{file}",
+		Retries:      -1,
+	})
+	def, err := c.FetchIntegration("AWS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Version != "1.1.118" || def.DownloadURL != srv.URL+"/pliant/packages/AWS/AWS_1.1.118.ssi.zip" {
+		t.Errorf("FetchIntegration = %+v", def)
+	}
+
+	path, err := c.DownloadPackage(def, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != packageBody {
+		t.Errorf("downloaded %q, %v", data, err)
+	}
+}
@@ -10,12 +10,14 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // IntegrationDef holds metadata for an integration
 type IntegrationDef struct {
 	Name        string // e.g. "AWS"
 	Version     string // e.g. "1.1.118"
+	FileName    string // e.g. "AWS_1.1.118.ssi.zip"
 	DownloadURL string // full URL to the .zip package
 }
 
@@ -31,21 +33,44 @@ func ExtractZip(zipPath string, targetDir string) error {
 // apiResponse wraps the JSON returned by the API
 type apiResponse struct {
 	Result struct {
-		Name          string `json:"Name"`
-		LatestVersion string `json:"LatestVersion"` // e.g. "AWS_1.1.118.ssi.zip"
+		Name          string   `json:"Name"`
+		LatestVersion string   `json:"LatestVersion"` // e.g. "AWS_1.1.118.ssi.zip"
+		Versions      []string `json:"Versions"`      // optional, e.g. ["AWS_1.1.117.ssi.zip", "AWS_1.1.118.ssi.zip"]
 	} `json:"result"`
 }
 
-// FetchIntegration retrieves the integration definition via the Pliant API
+// FetchIntegration retrieves the integration definition using the default client
 func FetchIntegration(name string) (*IntegrationDef, error) {
+	return NewClient(Options{}).FetchIntegration(name)
+}
+
+// FetchIntegration retrieves the latest version of an integration via the Pliant API
+func (c *Client) FetchIntegration(name string) (*IntegrationDef, error) {
+	return c.ResolveVersion(name, "")
+}
+
+// fetchDetails calls the integration details endpoint
+func (c *Client) fetchDetails(name string) (*apiResponse, error) {
 	// Call the JSON‑returning endpoint
-	apiURL := fmt.Sprintf(
-		"https://automation-library.ibm.com/api/getIntegrationDetails?Name=%s",
-		name,
-	)
-	resp, err := http.Get(apiURL)
+	apiURL := c.endpointURL(c.opts.DetailsPath, map[string]string{"name": name})
+
+	var apiResp apiResponse
+	if err := c.getJSON(apiURL, "integration details", &apiResp); err != nil {
+		return nil, err
+	}
+	if apiResp.Result.Name == "" {
+		apiResp.Result.Name = name
+	}
+
+	return &apiResp, nil
+}
+
+// getJSON fetches a JSON document from the API and decodes it into v.
+// what names the request in error messages (e.g. "integration details").
+func (c *Client) getJSON(apiURL, what string, v interface{}) error {
+	resp, err := c.get(apiURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to GET %s: %w", apiURL, err)
+		return fmt.Errorf("failed to GET %s: %w", apiURL, err)
 	}
 	defer resp.Body.Close()
 
@@ -54,7 +79,7 @@ func FetchIntegration(name string) (*IntegrationDef, error) {
 		fmt.Println("Fetching URL:", apiURL)
 		bodyBytes, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return nil, fmt.Errorf("reading response body: %w", err)
+			return fmt.Errorf("reading response body: %w", err)
 		}
 		fmt.Println("Response body:", string(bodyBytes))
 		// Reset the body for JSON decoding:
@@ -62,50 +87,57 @@ func FetchIntegration(name string) (*IntegrationDef, error) {
 		// --- DIAGNOSTIC LOGGING END ---
 	*/
 
+	// Check response status code
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s request failed with status %d: %s", what, resp.StatusCode, string(body))
+	}
+
 	// Ensure we got JSON, not HTML
 	ct := resp.Header.Get("Content-Type")
-	if ct == "" || ct[:16] != "application/json" {
+	if !strings.HasPrefix(ct, "application/json") {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf(
+		return fmt.Errorf(
 			"expected JSON response, got %q: %s",
 			ct, string(body),
 		)
 	}
 
-	// Decode the wrapper and pull out the version
-	var apiResp apiResponse
-
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("invalid JSON from API: %w", err)
+	// Decode the wrapper
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON from API: %w", err)
 	}
 
-	zipName := apiResp.Result.LatestVersion
-	downloadURL := fmt.Sprintf(
-		"https://automation-library.ibm.com/files/files/%s",
-		zipName,
-	)
+	return nil
+}
+
+// newIntegrationDef builds the definition for a package file of an integration
+func (c *Client) newIntegrationDef(name, version, fileName string) *IntegrationDef {
+	downloadURL := c.endpointURL(c.opts.DownloadPath, map[string]string{
+		"name": name,
+		"file": fileName,
+	})
 	return &IntegrationDef{
-		Name:        apiResp.Result.Name,
-		Version:     zipName,
+		Name:        name,
+		Version:     version,
+		FileName:    fileName,
 		DownloadURL: downloadURL,
-	}, nil
+	}
 }
 
-// DownloadPackage downloads and extracts the integration package
-// Returns the path to the downloaded zip file
+// DownloadPackage downloads the integration package using the default client
 func DownloadPackage(def *IntegrationDef, targetDir string) (string, error) {
-	fmt.Printf("Downloading from URL: %s\n", def.DownloadURL)
-	rsp, err := http.Get(def.DownloadURL)
-	if err != nil {
-		return "", fmt.Errorf("failed to download from %s: %w", def.DownloadURL, err)
-	}
-	defer rsp.Body.Close()
+	return NewClient(Options{}).DownloadPackage(def, targetDir)
+}
 
-	// Check response status code
-	if rsp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(rsp.Body)
-		return "", fmt.Errorf("download failed with status %d: %s", rsp.StatusCode, string(body))
-	}
+// DownloadPackage downloads the integration package into targetDir
+// Returns the path to the downloaded zip file.
+// Data is written to "<zip>.part" first; interrupted transfers are retried and
+// resumed with HTTP Range requests, including a partial file left by an earlier run.
+// The ETag or Last-Modified of the package is kept in "<zip>.part.validator" and sent
+// as If-Range, so a package that changed on the server is downloaded again from the start.
+func (c *Client) DownloadPackage(def *IntegrationDef, targetDir string) (string, error) {
+	fmt.Printf("Downloading from URL: %s\n", def.DownloadURL)
 
 	// create target directory
 	if err := os.MkdirAll(targetDir, 0755); err != nil {
@@ -113,25 +145,125 @@ func DownloadPackage(def *IntegrationDef, targetDir string) (string, error) {
 	}
 
 	// assume a zip archive
-	// Note: def.Version already includes the .zip extension, so we don't add it again
-	zipPath := filepath.Join(os.TempDir(), fmt.Sprintf("%s_%s", def.Name, def.Version))
+	// Note: def.FileName already includes the .zip extension, so we don't add it again
+	zipPath := filepath.Join(targetDir, def.FileName)
+	partPath := zipPath + partSuffix
 	fmt.Printf("Saving zip file to: %s\n", zipPath)
-	f, err := os.Create(zipPath)
+
+	for attempt := 1; ; attempt++ {
+		transient, err := c.downloadAttempt(def.DownloadURL, partPath)
+		if err == nil {
+			break
+		}
+		if !transient || attempt > c.opts.Retries {
+			return "", fmt.Errorf("failed to download from %s: %w", def.DownloadURL, err)
+		}
+		c.wait(attempt, err)
+	}
+
+	if err := os.Rename(partPath, zipPath); err != nil {
+		return "", fmt.Errorf("failed to write zip file: %w", err)
+	}
+	os.Remove(partPath + validatorSuffix)
+
+	info, err := os.Stat(zipPath)
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("Downloaded %d bytes to %s\n", info.Size(), zipPath)
+
+	return zipPath, nil
+}
+
+const (
+	// partSuffix is appended to the package file name while it is downloaded
+	partSuffix = ".part"
+	// validatorSuffix is appended to the partial file name for the file holding its If-Range validator
+	validatorSuffix = ".validator"
+)
+
+// downloadAttempt fetches the remainder of a package into partPath.
+// transient reports whether a failure is worth retrying.
+func (c *Client) downloadAttempt(rawURL, partPath string) (transient bool, err error) {
+	// Resume from whatever we already have
+	var offset int64
+	if info, err := os.Stat(partPath); err == nil {
+		offset = info.Size()
+	}
+	header := http.Header{}
+	if offset > 0 {
+		fmt.Fprintf(os.Stderr, "Resuming download at byte %d\n", offset)
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		// Only resume if the package is still the one the partial file belongs to
+		if validator, err := os.ReadFile(partPath + validatorSuffix); err == nil && len(validator) > 0 {
+			header.Set("If-Range", string(validator))
+		}
+	}
+
+	rsp, transient, err := c.send(c.download, rawURL, header)
+	if err != nil {
+		return transient, err
+	}
+	defer rsp.Body.Close()
+
+	// Check response status code
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	switch {
+	case rsp.StatusCode == http.StatusPartialContent && offset > 0:
+		// Make sure the server continues where we stopped
+		var start int64
+		if _, err := fmt.Sscanf(rsp.Header.Get("Content-Range"), "bytes %d-", &start); err != nil || start != offset {
+			os.Remove(partPath)
+			return true, fmt.Errorf("unexpected Content-Range %q, restarting download", rsp.Header.Get("Content-Range"))
+		}
+		flags = os.O_WRONLY | os.O_APPEND
+	case rsp.StatusCode == http.StatusOK:
+		if offset > 0 {
+			fmt.Fprintln(os.Stderr, "Server sent the whole package (it changed or resuming is not supported), restarting download")
+		}
+		saveValidator(partPath, rsp.Header)
+	case rsp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		// The partial file doesn't match the package any more
+		os.Remove(partPath)
+		os.Remove(partPath + validatorSuffix)
+		return true, fmt.Errorf("partial download is no longer valid, restarting")
+	default:
+		body, _ := io.ReadAll(io.LimitReader(rsp.Body, 4096))
+		return transient, fmt.Errorf("download failed with status %d: %s", rsp.StatusCode, string(body))
+	}
+
+	f, err := os.OpenFile(partPath, flags, 0644)
 	if err != nil {
-		return "", fmt.Errorf("failed to create zip file: %w", err)
+		return false, fmt.Errorf("failed to create zip file: %w", err)
 	}
 
-	// Copy the response body to the file
+	// Copy the response body to the file, keeping what arrived if the transfer breaks
 	bytesWritten, err := io.Copy(f, rsp.Body)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		return false, fmt.Errorf("failed to write zip file: %w", closeErr)
+	}
 	if err != nil {
-		f.Close()
-		return zipPath, fmt.Errorf("failed to write zip file: %w", err)
+		return true, fmt.Errorf("transfer interrupted after %d bytes: %w", bytesWritten, err)
+	}
+	if rsp.ContentLength >= 0 && bytesWritten != rsp.ContentLength {
+		return true, fmt.Errorf("transfer incomplete: got %d of %d bytes", bytesWritten, rsp.ContentLength)
 	}
-	f.Close()
 
-	fmt.Printf("Downloaded %d bytes to %s\n", bytesWritten, zipPath)
+	return false, nil
+}
 
-	return zipPath, nil
+// saveValidator remembers the strong ETag, or else the Last-Modified date, of a package
+// for If-Range when its download is resumed. Weak ETags cannot be used with If-Range.
+func saveValidator(partPath string, header http.Header) {
+	validator := header.Get("ETag")
+	if validator == "" || strings.HasPrefix(validator, "W/") {
+		validator = header.Get("Last-Modified")
+	}
+	if validator == "" {
+		os.Remove(partPath + validatorSuffix)
+		return
+	}
+	os.WriteFile(partPath+validatorSuffix, []byte(validator), 0644)
 }
 
 // Unzip is a helper to extract zip archives
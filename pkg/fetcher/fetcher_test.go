@@ -0,0 +1,265 @@
+// File: pkg/fetcher/fetcher_test.go
+
+package fetcher
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const packageBody = "PK-this-stands-in-for-a-zip-archive"
+
+// packageServer serves packageBody, honoring Range requests unless ignoreRange is set.
+// The first failures requests are answered with a 503. With an etag, Range requests
+// whose If-Range names another version get the whole package.
+type packageServer struct {
+	ignoreRange bool
+	failures    int
+	etag        string
+
+	mu       sync.Mutex
+	ranges   []string // Range header of every request
+	ifRanges []string // If-Range header of every request
+}
+
+func (s *packageServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.ranges = append(s.ranges, r.Header.Get("Range"))
+	s.ifRanges = append(s.ifRanges, r.Header.Get("If-Range"))
+	fail := len(s.ranges) <= s.failures
+	s.mu.Unlock()
+
+	if fail {
+		http.Error(w, "try again later", http.StatusServiceUnavailable)
+		return
+	}
+
+	if s.etag != "" {
+		w.Header().Set("ETag", s.etag)
+	}
+	var start int
+	ifRange := r.Header.Get("If-Range")
+	if rng := r.Header.Get("Range"); rng != "" && !s.ignoreRange && (ifRange == "" || ifRange == s.etag) {
+		if _, err := fmt.Sscanf(rng, "bytes=%d-", &start); err != nil || start >= len(packageBody) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(packageBody)-1, len(packageBody)))
+		w.WriteHeader(http.StatusPartialContent)
+	}
+	w.Write([]byte(packageBody[start:]))
+}
+
+func (s *packageServer) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.ranges...)
+}
+
+// download fetches the package from srv into dir, optionally starting from a partial file
+func download(t *testing.T, srv *httptest.Server, dir, partial string, retries int) (string, error) {
+	t.Helper()
+	if partial != "" {
+		if err := os.WriteFile(filepath.Join(dir, "AWS_1.1.118.ssi.zip.part"), []byte(partial), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c := NewClient(Options{BaseURL: srv.URL, Retries: retries, RetryBackoff: time.Millisecond})
+	return c.DownloadPackage(c.newIntegrationDef("AWS", "1.1.118", "AWS_1.1.118.ssi.zip"), dir)
+}
+
+// checkPackage verifies the downloaded file and that no partial file is left behind
+func checkPackage(t *testing.T, name, path string) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != packageBody {
+		t.Errorf("%s: downloaded %q, %v, want %q", name, data, err, packageBody)
+	}
+	if _, err := os.Stat(path + ".part"); !os.IsNotExist(err) {
+		t.Errorf("%s: partial file left behind", name)
+	}
+}
+
+func TestDownloadPackageResume(t *testing.T) {
+	tests := []struct {
+		name        string
+		partial     string
+		ignoreRange bool
+		wantRange   string
+	}{
+		{name: "fresh", wantRange: ""},
+		{name: "resume", partial: packageBody[:10], wantRange: "bytes=10-"},
+		{name: "range ignored", partial: packageBody[:10], ignoreRange: true, wantRange: "bytes=10-"},
+	}
+	for _, tt := range tests {
+		ps := &packageServer{ignoreRange: tt.ignoreRange}
+		srv := httptest.NewServer(ps)
+		path, err := download(t, srv, t.TempDir(), tt.partial, -1)
+		srv.Close()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		checkPackage(t, tt.name, path)
+		if got := ps.requests(); len(got) != 1 || got[0] != tt.wantRange {
+			t.Errorf("%s: requests with Range %q, want one with %q", tt.name, got, tt.wantRange)
+		}
+	}
+}
+
+func TestDownloadPackageIfRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator string // If-Range validator saved with the partial file
+		wantFull  bool   // whether the server sends the whole package
+	}{
+		{name: "same package", validator: `"v2"`},
+		{name: "package changed", validator: `"v1"`, wantFull: true},
+	}
+	for _, tt := range tests {
+		ps := &packageServer{etag: `"v2"`}
+		srv := httptest.NewServer(ps)
+		dir := t.TempDir()
+		partPath := filepath.Join(dir, "AWS_1.1.118.ssi.zip.part")
+		// A stale partial file of the old package is spliced into garbage unless it is discarded
+		partial := packageBody[:10]
+		if tt.wantFull {
+			partial = "OLDPACKAGE"
+		}
+		if err := os.WriteFile(partPath+".validator", []byte(tt.validator), 0644); err != nil {
+			t.Fatal(err)
+		}
+		path, err := download(t, srv, dir, partial, -1)
+		srv.Close()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		checkPackage(t, tt.name, path)
+		if got := ps.ifRanges; len(got) != 1 || got[0] != tt.validator {
+			t.Errorf("%s: If-Range %q, want %q", tt.name, got, tt.validator)
+		}
+		if _, err := os.Stat(partPath + ".validator"); !os.IsNotExist(err) {
+			t.Errorf("%s: validator left behind", tt.name)
+		}
+	}
+}
+
+func TestDownloadPackageSavesValidator(t *testing.T) {
+	// The first attempt breaks off, the retry resumes with the ETag of the first response
+	ps := &packageServer{etag: `"v2"`}
+	var mu sync.Mutex
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		first := requests == 1
+		mu.Unlock()
+		if first {
+			w.Header().Set("ETag", `"v2"`)
+			w.Header().Set("Content-Length", fmt.Sprint(len(packageBody)))
+			w.Write([]byte(packageBody[:12]))
+			w.(http.Flusher).Flush()
+			if conn, _, err := w.(http.Hijacker).Hijack(); err == nil {
+				conn.Close()
+			}
+			return
+		}
+		ps.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	path, err := download(t, srv, t.TempDir(), "", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPackage(t, "saved validator", path)
+	if got := ps.ifRanges; len(got) != 1 || got[0] != `"v2"` || ps.ranges[0] != "bytes=12-" {
+		t.Errorf("retry sent Range %q and If-Range %q, want bytes=12- with \"v2\"", ps.ranges, got)
+	}
+}
+
+func TestDownloadPackageStalePartial(t *testing.T) {
+	// A partial file as long as the package can't be resumed, the download restarts
+	ps := &packageServer{}
+	srv := httptest.NewServer(ps)
+	defer srv.Close()
+
+	path, err := download(t, srv, t.TempDir(), packageBody+"garbage", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPackage(t, "stale partial", path)
+	if got := ps.requests(); len(got) != 2 || got[0] == "" || got[1] != "" {
+		t.Errorf("requests with Range %q, want a ranged request then a full one", got)
+	}
+}
+
+func TestDownloadPackageRetry(t *testing.T) {
+	ps := &packageServer{failures: 2}
+	srv := httptest.NewServer(ps)
+	defer srv.Close()
+
+	path, err := download(t, srv, t.TempDir(), "", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPackage(t, "retry", path)
+	if got := len(ps.requests()); got != 3 {
+		t.Errorf("%d requests, want 3", got)
+	}
+
+	// Giving up after the configured retries
+	ps = &packageServer{failures: 5}
+	srv2 := httptest.NewServer(ps)
+	defer srv2.Close()
+	_, err = download(t, srv2, t.TempDir(), "", 2)
+	if err == nil || !strings.Contains(err.Error(), "status 503") {
+		t.Errorf("download error = %v, want a 503 failure", err)
+	}
+	if got := len(ps.requests()); got != 3 {
+		t.Errorf("%d requests, want 3", got)
+	}
+}
+
+func TestDownloadPackageInterrupted(t *testing.T) {
+	// The first response breaks off halfway, the retry resumes where it stopped
+	var mu sync.Mutex
+	var ranges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		ranges = append(ranges, r.Header.Get("Range"))
+		first := len(ranges) == 1
+		mu.Unlock()
+
+		if first {
+			w.Header().Set("Content-Length", fmt.Sprint(len(packageBody)))
+			w.Write([]byte(packageBody[:12]))
+			w.(http.Flusher).Flush()
+			// Drop the connection without sending the rest
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		(&packageServer{}).ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	path, err := download(t, srv, t.TempDir(), "", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPackage(t, "interrupted", path)
+	if len(ranges) != 2 || ranges[1] != "bytes=12-" {
+		t.Errorf("requests with Range %q, want the retry to resume at byte 12", ranges)
+	}
+}
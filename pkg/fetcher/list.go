@@ -0,0 +1,126 @@
+// File: pkg/fetcher/list.go
+
+package fetcher
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// IntegrationSummary describes an integration offered by the Pliant server
+type IntegrationSummary struct {
+	Name          string `json:"name"`          // e.g. "AWS"
+	LatestVersion string `json:"latestVersion"` // e.g. "1.1.118"
+	Description   string `json:"description"`
+}
+
+// maxListPages bounds how many pages of a list or search result are followed
+const maxListPages = 1000
+
+// listResponse wraps the JSON returned by the list and search endpoints.
+// Paged responses link to the following page in Next.
+type listResponse struct {
+	Result []struct {
+		Name          string `json:"Name"`
+		LatestVersion string `json:"LatestVersion"` // e.g. "AWS_1.1.118.ssi.zip"
+		Description   string `json:"Description"`
+	} `json:"result"`
+	Next string `json:"next"` // e.g. "/api/getIntegrations?page=2", empty on the last page
+}
+
+// ListIntegrations returns every integration on the server, sorted by name
+func (c *Client) ListIntegrations() ([]IntegrationSummary, error) {
+	apiURL := c.endpointURL(c.opts.ListPath, nil)
+
+	listResp, err := c.getPages(apiURL, "integration list")
+	if err != nil {
+		return nil, err
+	}
+	return summarize(listResp), nil
+}
+
+// getPages fetches a list or search result and every page it links to
+func (c *Client) getPages(apiURL, what string) (listResponse, error) {
+	var all listResponse
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		if seen[apiURL] || page > maxListPages {
+			return all, fmt.Errorf("%s: too many pages or a paging loop at %s", what, apiURL)
+		}
+		seen[apiURL] = true
+
+		var listResp listResponse
+		if err := c.getJSON(apiURL, what, &listResp); err != nil {
+			return all, err
+		}
+		all.Result = append(all.Result, listResp.Result...)
+		if listResp.Next == "" {
+			return all, nil
+		}
+
+		// Links are relative to the page they appear on
+		pageURL, err := url.Parse(apiURL)
+		if err != nil {
+			return all, fmt.Errorf("invalid %s URL %s: %w", what, apiURL, err)
+		}
+		next, err := pageURL.Parse(listResp.Next)
+		if err != nil {
+			return all, fmt.Errorf("invalid next page link %q: %w", listResp.Next, err)
+		}
+		apiURL = next.String()
+	}
+}
+
+// SearchIntegrations returns the integrations whose name or description contains the query.
+// The server's search endpoint is used if configured, otherwise the full list is filtered.
+func (c *Client) SearchIntegrations(query string) ([]IntegrationSummary, error) {
+	if c.opts.SearchPath != "" {
+		apiURL := c.endpointURL(c.opts.SearchPath, map[string]string{"query": query})
+
+		listResp, err := c.getPages(apiURL, "integration search")
+		if err != nil {
+			return nil, err
+		}
+		return summarize(listResp), nil
+	}
+
+	all, err := c.ListIntegrations()
+	if err != nil {
+		return nil, err
+	}
+
+	// Case-insensitive substring match on name and description
+	needle := strings.ToLower(query)
+	var matches []IntegrationSummary
+	for _, integration := range all {
+		if strings.Contains(strings.ToLower(integration.Name), needle) ||
+			strings.Contains(strings.ToLower(integration.Description), needle) {
+			matches = append(matches, integration)
+		}
+	}
+	return matches, nil
+}
+
+// summarize converts a list response into summaries sorted by name
+func summarize(listResp listResponse) []IntegrationSummary {
+	summaries := make([]IntegrationSummary, 0, len(listResp.Result))
+	for _, r := range listResp.Result {
+		// LatestVersion is usually a package file name
+		version := r.LatestVersion
+		if _, v, ok := ParsePackageFileName(r.LatestVersion); ok {
+			version = v
+		}
+		summaries = append(summaries, IntegrationSummary{
+			Name:          r.Name,
+			LatestVersion: version,
+			Description:   r.Description,
+		})
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return strings.ToLower(summaries[i].Name) < strings.ToLower(summaries[j].Name)
+	})
+	return summaries
+}
@@ -0,0 +1,152 @@
+// File: pkg/fetcher/list_test.go
+
+package fetcher
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// catalog is the integration list of the stand-in server, served two per page
+var catalog = []map[string]string{
+	{"Name": "Slack", "LatestVersion": "Slack_2.0.0.ssi.zip", "Description": "Team chat"},
+	{"Name": "AWS", "LatestVersion": "AWS_1.1.118.ssi.zip", "Description": "Amazon Web Services"},
+	{"Name": "azure", "LatestVersion": "3.1.0", "Description": "Microsoft cloud"},
+	{"Name": "Jira", "LatestVersion": "Jira_9.4.0.zip", "Description": "Issue tracking for AWS teams"},
+	{"Name": "GitHub", "LatestVersion": "GitHub_1.0.0.ssi.zip", "Description": "Code hosting"},
+}
+
+// listServer serves the catalog on /api/getIntegrations and /api/search?q=, paged
+// via "next" links, and records the query of every search request
+func listServer(t *testing.T, searches *[]string) *httptest.Server {
+	t.Helper()
+	page := func(w http.ResponseWriter, r *http.Request, items []map[string]string) {
+		n, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			n = 1
+		}
+		start, end := (n-1)*2, n*2
+		resp := map[string]interface{}{}
+		if end < len(items) {
+			q := r.URL.Query()
+			q.Set("page", strconv.Itoa(n+1))
+			resp["next"] = "?" + q.Encode()
+		} else {
+			end = len(items)
+		}
+		resp["result"] = items[start:end]
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/getIntegrations", func(w http.ResponseWriter, r *http.Request) {
+		page(w, r, catalog)
+	})
+	mux.HandleFunc("/api/search", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("q")
+		if r.URL.Query().Get("page") == "" {
+			*searches = append(*searches, query)
+		}
+		var matches []map[string]string
+		for _, item := range catalog {
+			if strings.Contains(item["Name"], query) {
+				matches = append(matches, item)
+			}
+		}
+		page(w, r, matches)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// names returns the names of integration summaries
+func names(integrations []IntegrationSummary) string {
+	var list []string
+	for _, i := range integrations {
+		list = append(list, i.Name)
+	}
+	return strings.Join(list, ",")
+}
+
+func TestListIntegrations(t *testing.T) {
+	srv := listServer(t, nil)
+	c := NewClient(Options{BaseURL: srv.URL, Retries: -1})
+
+	integrations, err := c.ListIntegrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every page is collected and the result sorted by name regardless of case
+	if got := names(integrations); got != "AWS,azure,GitHub,Jira,Slack" {
+		t.Errorf("ListIntegrations = %s", got)
+	}
+	aws := integrations[0]
+	if aws.LatestVersion != "1.1.118" || aws.Description != "Amazon Web Services" {
+		t.Errorf("AWS = %+v", aws)
+	}
+	// Versions that are not package file names are kept as they are
+	if azure := integrations[1]; azure.LatestVersion != "3.1.0" {
+		t.Errorf("azure version = %s", azure.LatestVersion)
+	}
+}
+
+func TestSearchIntegrations(t *testing.T) {
+	var searches []string
+	srv := listServer(t, &searches)
+
+	// Without a search endpoint the list is filtered locally on name and description
+	local := NewClient(Options{BaseURL: srv.URL, Retries: -1})
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"aws", "AWS,Jira"},
+		{"CLOUD", "azure"},
+		{"git", "GitHub"},
+		{"nothing", ""},
+	}
+	for _, tt := range tests {
+		got, err := local.SearchIntegrations(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names(got) != tt.want {
+			t.Errorf("local search %q = %s, want %s", tt.query, names(got), tt.want)
+		}
+	}
+
+	// The server's search endpoint gets the escaped query and may page its results
+	remote := NewClient(Options{BaseURL: srv.URL, SearchPath: "/api/search?q={query}", Retries: -1})
+	got, err := remote.SearchIntegrations("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names(got) != "azure,Jira,Slack" {
+		t.Errorf("remote search = %s", names(got))
+	}
+	if _, err := remote.SearchIntegrations("Git Hub&co"); err != nil {
+		t.Fatal(err)
+	}
+	if len(searches) != 2 || searches[1] != "Git Hub&co" {
+		t.Errorf("server received queries %q", searches)
+	}
+}
+
+func TestListIntegrationsPagingLoop(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"result": [], "next": "/api/getIntegrations"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(Options{BaseURL: srv.URL, Retries: -1})
+	if _, err := c.ListIntegrations(); err == nil || !strings.Contains(err.Error(), "paging loop") {
+		t.Errorf("ListIntegrations error = %v, want a paging loop", err)
+	}
+}
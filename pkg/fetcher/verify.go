@@ -0,0 +1,188 @@
+// File: pkg/fetcher/verify.go
+
+package fetcher
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ChecksumError is returned when a package does not match its expected digest
+type ChecksumError struct {
+	Path     string
+	Source   string // where the expected digest came from, e.g. "server" or "lockfile"
+	Expected string
+	Actual   string
+}
+
+// Error describes the mismatch
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("checksum mismatch for %s: %s expects sha256 %s, package has %s",
+		e.Path, e.Source, e.Expected, e.Actual)
+}
+
+// VerifySHA256 checks that a file has the expected hex-encoded SHA-256 digest
+func VerifySHA256(path, expected, source string) error {
+	actual, err := FileSHA256(path)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(actual, strings.TrimSpace(expected)) {
+		return &ChecksumError{Path: path, Source: source, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// ParseChecksum extracts the digest for fileName from a checksum file.
+// Both a bare digest and the "sha256sum" format ("<digest>  <file>" per line) are accepted.
+func ParseChecksum(data []byte, fileName string) (string, error) {
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		// A single digest applies to whatever file it was published for
+		if len(fields) == 1 && len(lines) == 1 {
+			return validDigest(fields[0])
+		}
+
+		// "<digest>  <file>", sha256sum marks binary mode with a leading "*"
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == fileName {
+			return validDigest(fields[0])
+		}
+	}
+	return "", fmt.Errorf("no checksum for %s found", fileName)
+}
+
+// validDigest checks that s looks like a hex-encoded SHA-256 digest
+func validDigest(s string) (string, error) {
+	if b, err := hex.DecodeString(s); err != nil || len(b) != sha256.Size {
+		return "", fmt.Errorf("invalid sha256 digest %q", s)
+	}
+	return strings.ToLower(s), nil
+}
+
+// LoadPublicKey reads a PEM-encoded public key (Ed25519, ECDSA or RSA)
+func LoadPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("public key %s is not PEM encoded", path)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key %s: %w", path, err)
+	}
+
+	switch key.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey, *rsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T in %s", key, path)
+	}
+}
+
+// VerifySignature checks a detached signature over the file contents.
+// Ed25519 signs the file itself, ECDSA and RSA (PKCS #1 v1.5) sign its SHA-256 digest.
+// The signature may be raw bytes or base64 encoded.
+func VerifySignature(path string, sig []byte, key crypto.PublicKey) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	sig = decodeSignature(sig)
+	digest := sha256.Sum256(data)
+
+	valid := false
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, sig)
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, digest[:], sig)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+
+	if !valid {
+		return fmt.Errorf("signature verification failed for %s", path)
+	}
+	return nil
+}
+
+// decodeSignature accepts base64 text as well as raw signature bytes
+func decodeSignature(sig []byte) []byte {
+	text := bytes.TrimSpace(sig)
+	if decoded, err := base64.StdEncoding.DecodeString(string(text)); err == nil {
+		return decoded
+	}
+	return sig
+}
+
+// FetchChecksum downloads the checksum the server publishes for a package.
+// It returns "" if no checksum endpoint is configured.
+func (c *Client) FetchChecksum(def *IntegrationDef) (string, error) {
+	if c.opts.ChecksumPath == "" {
+		return "", nil
+	}
+
+	data, err := c.fetchSidecar(c.opts.ChecksumPath, def)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch checksum: %w", err)
+	}
+	return ParseChecksum(data, def.FileName)
+}
+
+// FetchSignature downloads the detached signature the server publishes for a package.
+// It returns nil if no signature endpoint is configured.
+func (c *Client) FetchSignature(def *IntegrationDef) ([]byte, error) {
+	if c.opts.SignaturePath == "" {
+		return nil, nil
+	}
+
+	data, err := c.fetchSidecar(c.opts.SignaturePath, def)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signature: %w", err)
+	}
+	return data, nil
+}
+
+// fetchSidecar downloads a small file published next to a package
+func (c *Client) fetchSidecar(pathTmpl string, def *IntegrationDef) ([]byte, error) {
+	sidecarURL := c.endpointURL(pathTmpl, map[string]string{
+		"name": def.Name,
+		"file": def.FileName,
+	})
+	resp, err := c.get(sidecarURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET %s: %w", sidecarURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s failed with status %d", sidecarURL, resp.StatusCode)
+	}
+
+	// Sidecar files are tiny, refuse anything unreasonable
+	return io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+}
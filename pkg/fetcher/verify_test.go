@@ -0,0 +1,177 @@
+// File: pkg/fetcher/verify_test.go
+
+package fetcher
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	digestA = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	digestB = "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+)
+
+func TestParseChecksum(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{name: "bare digest", data: digestA + "\n", want: digestA},
+		{name: "upper case", data: strings.ToUpper(digestA), want: digestA},
+		{name: "sha256sum", data: digestB + "  Other_1.0.0.ssi.zip\n" + digestA + "  AWS_1.1.118.ssi.zip\n", want: digestA},
+		{name: "binary mode", data: digestA + " *AWS_1.1.118.ssi.zip", want: digestA},
+		{name: "single line for another file", data: digestB + "  Other_1.0.0.ssi.zip", wantErr: true},
+		{name: "not hex", data: strings.Repeat("z", 64), wantErr: true},
+		{name: "too short", data: digestA[:32], wantErr: true},
+		{name: "empty", data: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseChecksum([]byte(tt.data), "AWS_1.1.118.ssi.zip")
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: ParseChecksum = %s, want an error", tt.name, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: ParseChecksum = %s, %v, want %s", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestVerifySHA256(t *testing.T) {
+	path := writeFile(t, "test")
+	if err := VerifySHA256(path, strings.ToUpper(digestA)+"\n", "server"); err != nil {
+		t.Errorf("VerifySHA256 with the right digest: %v", err)
+	}
+
+	err := VerifySHA256(path, digestB, "lockfile")
+	var mismatch *ChecksumError
+	if !errors.As(err, &mismatch) || mismatch.Actual != digestA || mismatch.Source != "lockfile" {
+		t.Errorf("VerifySHA256 with the wrong digest: %v", err)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	path := writeFile(t, "package bytes")
+	data := []byte("package bytes")
+	digest := sha256.Sum256(data)
+
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPriv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecPriv, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaPriv, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []struct {
+		name string
+		pub  crypto.PublicKey
+		sig  []byte
+	}{
+		{"ed25519", edPub, ed25519.Sign(edPriv, data)},
+		{"ecdsa", &ecPriv.PublicKey, ecSig},
+		{"rsa", &rsaPriv.PublicKey, rsaSig},
+	}
+	for _, k := range keys {
+		// Round-trip the key through PEM the way --public-key loads it
+		pub := loadKey(t, k.pub)
+
+		if err := VerifySignature(path, k.sig, pub); err != nil {
+			t.Errorf("%s: raw signature: %v", k.name, err)
+		}
+		encoded := base64.StdEncoding.EncodeToString(k.sig) + "\n"
+		if err := VerifySignature(path, []byte(encoded), pub); err != nil {
+			t.Errorf("%s: base64 signature: %v", k.name, err)
+		}
+
+		tampered := writeFile(t, "package bytes!")
+		if err := VerifySignature(tampered, k.sig, pub); err == nil {
+			t.Errorf("%s: tampered file passed verification", k.name)
+		}
+	}
+
+	// A signature made with another key is rejected
+	otherPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	if err := VerifySignature(path, keys[0].sig, otherPub); err == nil {
+		t.Error("signature verified with the wrong key")
+	}
+}
+
+func TestLoadPublicKeyErrors(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "key.txt")
+	os.WriteFile(notPEM, []byte("not a key"), 0644)
+	if _, err := LoadPublicKey(notPEM); err == nil {
+		t.Error("LoadPublicKey accepted a file that is not PEM")
+	}
+
+	garbage := filepath.Join(dir, "key.pem")
+	os.WriteFile(garbage, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("garbage")}), 0644)
+	if _, err := LoadPublicKey(garbage); err == nil {
+		t.Error("LoadPublicKey accepted an invalid key")
+	}
+
+	if _, err := LoadPublicKey(filepath.Join(dir, "missing.pem")); err == nil {
+		t.Error("LoadPublicKey accepted a missing file")
+	}
+}
+
+// writeFile writes content to a temporary file and returns its path
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "AWS_1.1.118.ssi.zip")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// loadKey writes a public key as PEM and loads it back with LoadPublicKey
+func loadKey(t *testing.T, pub crypto.PublicKey) crypto.PublicKey {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	key, err := LoadPublicKey(path)
+	if err != nil {
+		t.Fatalf("LoadPublicKey: %v", err)
+	}
+	return key
+}
@@ -0,0 +1,150 @@
+// File: pkg/fetcher/version.go
+
+package fetcher
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/strongcodr/lowcodefusion/pkg/semver"
+)
+
+// packageSuffixes are stripped from package file names, longest first
+var packageSuffixes = []string{".ssi.zip", ".zip"}
+
+// ParsePackageFileName splits a package file name into integration name and version.
+// e.g. "AWS_1.1.118.ssi.zip" -> ("AWS", "1.1.118")
+func ParsePackageFileName(fileName string) (name string, version string, ok bool) {
+	base := fileName
+	for _, suffix := range packageSuffixes {
+		if strings.HasSuffix(strings.ToLower(base), suffix) {
+			base = base[:len(base)-len(suffix)]
+			break
+		}
+	}
+
+	// The version follows the last underscore
+	idx := strings.LastIndex(base, "_")
+	if idx <= 0 || idx == len(base)-1 {
+		return "", "", false
+	}
+	if _, err := semver.Parse(base[idx+1:]); err != nil {
+		return "", "", false
+	}
+	return base[:idx], base[idx+1:], true
+}
+
+// PackageFileName builds the conventional package file name for a version
+func PackageFileName(name, version string) string {
+	return fmt.Sprintf("%s_%s.ssi.zip", name, version)
+}
+
+// availableVersion is a version offered by the server and the package file that holds it
+type availableVersion struct {
+	version  semver.Version
+	fileName string
+}
+
+// availableVersions collects the versions listed in a details response, sorted ascending
+func availableVersions(apiResp *apiResponse) []availableVersion {
+	name := apiResp.Result.Name
+	entries := append([]string{}, apiResp.Result.Versions...)
+	entries = append(entries, apiResp.Result.LatestVersion)
+
+	seen := make(map[string]bool)
+	versions := make([]availableVersion, 0, len(entries))
+	for _, entry := range entries {
+		if entry == "" {
+			continue
+		}
+
+		// Entries are either package file names or bare versions
+		fileName := entry
+		versionStr := entry
+		if _, v, ok := ParsePackageFileName(entry); ok {
+			versionStr = v
+		} else {
+			fileName = PackageFileName(name, entry)
+		}
+
+		v, err := semver.Parse(versionStr)
+		if err != nil || seen[v.String()] {
+			continue
+		}
+		seen[v.String()] = true
+		versions = append(versions, availableVersion{version: v, fileName: fileName})
+	}
+
+	// Sort ascending
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].version.Compare(versions[j].version) < 0
+	})
+
+	return versions
+}
+
+// ListVersions returns the versions available for an integration, oldest first.
+// Servers that don't list versions only report the latest one.
+func (c *Client) ListVersions(name string) ([]string, error) {
+	apiResp, err := c.fetchDetails(name)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := availableVersions(apiResp)
+	result := make([]string, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, v.version.Original)
+	}
+	return result, nil
+}
+
+// ResolveVersion returns the definition of the highest version of an integration
+// that satisfies the constraint (e.g. "1.1.118", "~1.1.0", "^1.0.0", ">=1.1.0 <1.2.0").
+// An empty constraint or "latest" resolves to the server's latest version.
+func (c *Client) ResolveVersion(name, constraint string) (*IntegrationDef, error) {
+	apiResp, err := c.fetchDetails(name)
+	if err != nil {
+		return nil, err
+	}
+	name = apiResp.Result.Name
+
+	// No constraint, use the latest version as reported by the server
+	if constraint == "" || constraint == "latest" {
+		fileName := apiResp.Result.LatestVersion
+		if fileName == "" {
+			return nil, fmt.Errorf("integration %s has no published version", name)
+		}
+		version := fileName
+		if _, v, ok := ParsePackageFileName(fileName); ok {
+			version = v
+		}
+		return c.newIntegrationDef(name, version, fileName), nil
+	}
+
+	vc, err := semver.ParseConstraint(constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pick the highest matching version
+	versions := availableVersions(apiResp)
+	for i := len(versions) - 1; i >= 0; i-- {
+		if vc.Check(versions[i].version) {
+			return c.newIntegrationDef(name, versions[i].version.Original, versions[i].fileName), nil
+		}
+	}
+
+	// The server may not list old versions, so trust an exact pin,
+	// normalized so "v1.1.118" names AWS_1.1.118.ssi.zip
+	if exact, ok := vc.Exact(); ok {
+		return c.newIntegrationDef(name, exact.String(), PackageFileName(name, exact.String())), nil
+	}
+
+	available := make([]string, 0, len(versions))
+	for _, v := range versions {
+		available = append(available, v.version.Original)
+	}
+	return nil, fmt.Errorf("no version of %s matches %q (available: %s)", name, constraint, strings.Join(available, ", "))
+}
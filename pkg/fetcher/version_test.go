@@ -0,0 +1,77 @@
+// File: pkg/fetcher/version_test.go
+
+package fetcher
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParsePackageFileName(t *testing.T) {
+	tests := []struct {
+		file      string
+		name, ver string
+		ok        bool
+	}{
+		{"AWS_1.1.118.ssi.zip", "AWS", "1.1.118", true},
+		{"My_Integration_2.0.0-beta.1.zip", "My_Integration", "2.0.0-beta.1", true},
+		{"AWS.ssi.zip", "", "", false},
+		{"AWS_latest.ssi.zip", "", "", false},
+		{"_1.0.0.zip", "", "", false},
+	}
+	for _, tt := range tests {
+		name, ver, ok := ParsePackageFileName(tt.file)
+		if name != tt.name || ver != tt.ver || ok != tt.ok {
+			t.Errorf("ParsePackageFileName(%q) = %q, %q, %v, want %q, %q, %v", tt.file, name, ver, ok, tt.name, tt.ver, tt.ok)
+		}
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		resp := map[string]interface{}{"result": map[string]interface{}{
+			"Name":          "AWS",
+			"LatestVersion": "AWS_1.2.0.ssi.zip",
+			"Versions":      []string{"AWS_1.1.117.ssi.zip", "AWS_1.1.118.ssi.zip", "1.2.0-rc.1"},
+		}}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+	c := NewClient(Options{BaseURL: srv.URL, Retries: -1})
+
+	tests := []struct {
+		constraint string
+		version    string
+		file       string
+	}{
+		{"", "1.2.0", "AWS_1.2.0.ssi.zip"},
+		{"latest", "1.2.0", "AWS_1.2.0.ssi.zip"},
+		{"~1.1.0", "1.1.118", "AWS_1.1.118.ssi.zip"},
+		{"1.1.117", "1.1.117", "AWS_1.1.117.ssi.zip"},
+		{"^1.0.0", "1.2.0", "AWS_1.2.0.ssi.zip"},
+		{">=1.2.0-rc.1 <1.2.0", "1.2.0-rc.1", "AWS_1.2.0-rc.1.ssi.zip"},
+		// Versions the server does not list are trusted when pinned, under their normalized name
+		{"1.0.5", "1.0.5", "AWS_1.0.5.ssi.zip"},
+		{"v1.0.5", "1.0.5", "AWS_1.0.5.ssi.zip"},
+	}
+	for _, tt := range tests {
+		def, err := c.ResolveVersion("AWS", tt.constraint)
+		if err != nil {
+			t.Errorf("ResolveVersion(%q): %v", tt.constraint, err)
+			continue
+		}
+		if def.Version != tt.version || def.FileName != tt.file {
+			t.Errorf("ResolveVersion(%q) = %s (%s), want %s (%s)", tt.constraint, def.Version, def.FileName, tt.version, tt.file)
+		}
+		if want := srv.URL + "/files/files/" + tt.file; def.DownloadURL != want {
+			t.Errorf("ResolveVersion(%q).DownloadURL = %s, want %s", tt.constraint, def.DownloadURL, want)
+		}
+	}
+
+	if _, err := c.ResolveVersion("AWS", "^3.0.0"); err == nil {
+		t.Error("ResolveVersion(^3.0.0) should fail when no version matches")
+	}
+}
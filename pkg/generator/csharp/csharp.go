@@ -0,0 +1,45 @@
+// File: pkg/generator/csharp/csharp.go
+
+package csharp
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Generator is the C# target of lcf generate --lang csharp
+type Generator struct {
+	opts Options
+}
+
+func init() {
+	generator.Register(&Generator{opts: Options{Formats: DefaultFormats.Clone()}})
+}
+
+// Name selects the generator with --lang
+func (g *Generator) Name() string {
+	return "csharp"
+}
+
+// Description summarizes the generated SDK
+func (g *Generator) Description() string {
+	return ".NET class library with records, enums and a client class per service"
+}
+
+// Capabilities lists the optional features of the C# SDK
+func (g *Generator) Capabilities() []generator.Capability {
+	return []generator.Capability{generator.TypedModels}
+}
+
+// Flags registers the C#-specific flags
+func (g *Generator) Flags(fs *pflag.FlagSet) {
+	fs.StringVarP(&g.opts.Namespace, "csharp-namespace", "", "", "Root namespace and package id of the C# SDK (default Pliant.Sdk.<Integration>)")
+	fs.Var(g.opts.Formats, "csharp-format", "C# type of a JSON Schema format, as format=Type[@namespace] (repeatable)")
+}
+
+// Generate writes the C# SDK for an integration
+func (g *Generator) Generate(integration *ir.Integration, outDir string) error {
+	return Generate(integration, outDir, g.opts)
+}
@@ -0,0 +1,603 @@
+// File: pkg/generator/csharp/generator.go
+
+package csharp
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Operation is the template view of a client method
+type Operation struct {
+	Name       string      // method name (e.g. "RunInstances")
+	FlowName   string      // flow name (e.g. "RunInstances")
+	Doc        []string    // lines of the XML doc comment
+	Params     []Parameter // required parameters first
+	ReturnType string      // "void" if the flow has no output
+}
+
+// Parameter is a method parameter
+type Parameter struct {
+	Name     string
+	Type     string
+	Required bool // optional parameters default to null
+}
+
+// Options are the C#-specific generator settings
+type Options struct {
+	Namespace string                // root namespace, also the assembly and package name
+	Formats   generator.FormatTable // JSON Schema format -> C# type, DefaultFormats if nil
+}
+
+// DefaultFormats maps JSON Schema formats to C# types; other formats stay strings.
+// "time" stays a string as TimeOnly cannot hold the offset of an RFC 3339 time.
+var DefaultFormats = generator.FormatTable{
+	"date-time": {Type: "DateTimeOffset", Import: "System", JSONType: "string"},
+	"date":      {Type: "DateOnly", Import: "System", JSONType: "string"},
+	"uuid":      {Type: "Guid", Import: "System", JSONType: "string"},
+	"uri":       {Type: "Uri", Import: "System", JSONType: "string"},
+	"byte":      {Type: "byte[]", JSONType: "string"}, // System.Text.Json uses base64 for byte[]
+	"int32":     {Type: "int", JSONType: "integer"},
+	"int64":     {Type: "long", JSONType: "integer"},
+	"float":     {Type: "float", JSONType: "number"},
+	"double":    {Type: "double", JSONType: "number"},
+}
+
+// targetFramework is the framework the generated library builds for
+const targetFramework = "net8.0"
+
+// reservedWords cannot be used as identifiers without an @ prefix
+var reservedWords = map[string]bool{
+	"abstract": true, "as": true, "base": true, "bool": true, "break": true, "byte": true,
+	"case": true, "catch": true, "char": true, "checked": true, "class": true, "const": true,
+	"continue": true, "decimal": true, "default": true, "delegate": true, "do": true, "double": true,
+	"else": true, "enum": true, "event": true, "explicit": true, "extern": true, "false": true,
+	"finally": true, "fixed": true, "float": true, "for": true, "foreach": true, "goto": true,
+	"if": true, "implicit": true, "in": true, "int": true, "interface": true, "internal": true,
+	"is": true, "lock": true, "long": true, "namespace": true, "new": true, "null": true,
+	"object": true, "operator": true, "out": true, "override": true, "params": true, "private": true,
+	"protected": true, "public": true, "readonly": true, "ref": true, "return": true, "sbyte": true,
+	"sealed": true, "short": true, "sizeof": true, "stackalloc": true, "static": true, "string": true,
+	"struct": true, "switch": true, "this": true, "throw": true, "true": true, "try": true,
+	"typeof": true, "uint": true, "ulong": true, "unchecked": true, "unsafe": true, "ushort": true,
+	"using": true, "virtual": true, "void": true, "volatile": true, "while": true,
+}
+
+// Generate writes a .NET class library for an integration:
+//
+//	<out>/<integration>/<Namespace>.csproj
+//	<out>/<integration>/<Service>/<Service>Client.cs  one method per operation
+//	<out>/<integration>/<Service>/Models/*.cs         records and enums of the service
+func Generate(integration *ir.Integration, outDir string, opts Options) error {
+	projectDir := filepath.Join(outDir, integration.Name)
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		return fmt.Errorf("failed to create project directory %s: %v", projectDir, err)
+	}
+	fmt.Printf("Project directory: %s\n", projectDir)
+
+	formats := opts.Formats
+	if formats == nil {
+		formats = DefaultFormats
+	}
+
+	rootNamespace := opts.Namespace
+	if rootNamespace == "" {
+		rootNamespace = "Pliant.Sdk." + generator.TypeName(integration.Name)
+	}
+
+	tmpl, err := loadTemplate()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Generating C# classes:")
+	count := 0
+	for _, service := range integration.Services {
+		model := generator.NewServiceModel(service)
+
+		// Operations directly in the integration directory go to the root namespace
+		namespace := rootNamespace
+		serviceDir := projectDir
+		className := generator.TypeName(integration.Name) + "Client"
+		if service.Name != "" {
+			namespace = rootNamespace + "." + generator.TypeName(service.Name)
+			serviceDir = filepath.Join(projectDir, generator.TypeName(service.Name))
+			className = generator.TypeName(service.Name) + "Client"
+		}
+		modelNamespace := namespace + ".Models"
+		types := newTypeMapper(model.Decls(), formats)
+
+		// Write the records and enums
+		for _, decl := range model.Decls() {
+			if !types.declared(decl) {
+				continue // C# has no type aliases, uses of the alias get the aliased type
+			}
+			modelPath := filepath.Join(serviceDir, "Models", decl.Name+".cs")
+			if err := writeModel(modelPath, modelNamespace, decl, types); err != nil {
+				return err
+			}
+		}
+
+		// Write the client class
+		clientPath := filepath.Join(serviceDir, className+".cs")
+		if err := writeClient(tmpl, clientPath, integration.Name, service.Name, namespace, className, model, types); err != nil {
+			return err
+		}
+		count += len(model.Operations)
+
+		fmt.Printf("  - Generated: %s\n", clientPath)
+	}
+
+	if err := writeProject(projectDir, integration, rootNamespace); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nSuccessfully generated %d C# methods\n", count)
+	return nil
+}
+
+// loadTemplate reads the client class template
+func loadTemplate() (*template.Template, error) {
+	tmplPath := "templates/csharp_client.tmpl"
+	tmplContent, err := os.ReadFile(tmplPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file %s: %v", tmplPath, err)
+	}
+
+	tmpl, err := template.New("csharp_client").Parse(string(tmplContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// writeClient renders the client class of a service
+func writeClient(
+	tmpl *template.Template,
+	filePath string,
+	integrationName string,
+	serviceName string,
+	namespace string,
+	className string,
+	model *generator.ServiceModel,
+	types *typeMapper,
+) error {
+	types.usings = map[string]bool{"System": true}
+
+	var ops []Operation
+	methodNames := map[string]bool{className: true}
+	for _, op := range model.Operations {
+		name := generator.TypeName(op.Operation.Name)
+		for methodNames[name] {
+			name += "Operation"
+		}
+		methodNames[name] = true
+
+		view := Operation{
+			Name:       name,
+			FlowName:   op.Operation.Name,
+			ReturnType: "void",
+		}
+		view.Doc = append(view.Doc, "<summary>")
+		if lines := docLines(op.Operation.Description); len(lines) > 0 {
+			view.Doc = append(view.Doc, lines...)
+		} else {
+			view.Doc = append(view.Doc, "Runs the "+xmlText(op.Operation.Name)+" flow.")
+		}
+		view.Doc = append(view.Doc, "</summary>")
+
+		// Optional parameters have to follow the required ones
+		var required, optional []Parameter
+		paramNames := make(map[string]bool)
+		for _, input := range op.Inputs {
+			paramName := parameterName(input.Name)
+			for paramNames[paramName] {
+				paramName += "_"
+			}
+			paramNames[paramName] = true
+
+			param := Parameter{
+				Name:     paramName,
+				Type:     types.fieldType(input.Schema, !input.Required),
+				Required: input.Required,
+			}
+			if param.Required {
+				required = append(required, param)
+			} else {
+				optional = append(optional, param)
+			}
+
+			if description := firstLine(input.Description); description != "" {
+				view.Doc = append(view.Doc, fmt.Sprintf("<param name=\"%s\">%s</param>", strings.TrimPrefix(paramName, "@"), xmlText(description)))
+			}
+		}
+		view.Params = append(required, optional...)
+
+		if op.Output != nil {
+			view.ReturnType = types.csType(op.Output.Schema)
+			if description := firstLine(op.Output.Description); description != "" {
+				view.Doc = append(view.Doc, "<returns>"+xmlText(description)+"</returns>")
+			}
+		}
+		ops = append(ops, view)
+	}
+
+	// Signatures refer to the model namespace and framework types
+	for _, decl := range model.Decls() {
+		if types.declared(decl) {
+			types.usings[namespace+".Models"] = true
+			break
+		}
+	}
+
+	service := serviceName
+	if service == "" {
+		service = integrationName
+	}
+	data := struct {
+		Integration string
+		Service     string
+		Namespace   string
+		Class       string
+		Usings      []string
+		Ops         []Operation
+	}{
+		Integration: integrationName,
+		Service:     xmlText(service),
+		Namespace:   namespace,
+		Class:       className,
+		Usings:      sortedUsings(types.usings),
+		Ops:         ops,
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return fmt.Errorf("failed to execute template: %v", err)
+	}
+	return writeFile(filePath, buffer.String())
+}
+
+// writeModel writes a record or enum for a declaration
+func writeModel(filePath, namespace string, decl generator.Decl, types *typeMapper) error {
+	types.usings = make(map[string]bool)
+
+	var body string
+	switch decl.Kind {
+	case generator.StructDecl:
+		body = csRecord(decl, types)
+	case generator.EnumDecl:
+		body = csEnum(decl, types)
+	}
+
+	content := "// Generated by LowCodeFusion\n"
+	for _, using := range sortedUsings(types.usings) {
+		content += fmt.Sprintf("using %s;\n", using)
+	}
+	content += fmt.Sprintf("\nnamespace %s;\n\n", namespace)
+	content += summary(decl.Description, "From: "+decl.Source, "") + body
+
+	return writeFile(filePath, content)
+}
+
+// csRecord renders a record with init-only properties; required properties use the required modifier
+func csRecord(decl generator.Decl, types *typeMapper) string {
+	types.usings["System.Text.Json.Serialization"] = true
+
+	var properties []string
+	// A member cannot have the name of its type
+	propNames := map[string]bool{decl.Name: true}
+	for _, propName := range decl.Schema.PropertyNames() {
+		prop := decl.Schema.Properties[propName]
+
+		name := generator.TypeName(propName)
+		for propNames[name] {
+			name += "Value"
+		}
+		propNames[name] = true
+
+		property := ""
+		if description := firstLine(prop.Description); description != "" {
+			property += summary(description, "", "    ")
+		}
+		property += fmt.Sprintf("    [JsonPropertyName(%s)]\n", csString(propName))
+		if decl.Schema.IsRequired(propName) {
+			property += fmt.Sprintf("    public required %s %s { get; init; }\n", types.csType(prop), name)
+		} else {
+			property += "    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]\n"
+			property += fmt.Sprintf("    public %s %s { get; init; }\n", types.fieldType(prop, true), name)
+		}
+		properties = append(properties, property)
+	}
+
+	return fmt.Sprintf("public sealed record %s\n{\n%s}\n", decl.Name, strings.Join(properties, "\n"))
+}
+
+// csEnum renders an enum; string values are mapped by a generated converter, integers are the enum values
+func csEnum(decl generator.Decl, types *typeMapper) string {
+	var members []string
+	memberNames := map[string]bool{decl.Name: true}
+	for i, val := range decl.Schema.Enum {
+		name := enumMemberName(val, i)
+		for memberNames[name] {
+			name += "_"
+		}
+		memberNames[name] = true
+		members = append(members, name)
+	}
+
+	if decl.Schema.Type == "integer" {
+		result := fmt.Sprintf("public enum %s : long\n{\n", decl.Name)
+		for i, name := range members {
+			result += fmt.Sprintf("    %s = %s,\n", name, decl.Schema.Enum[i])
+		}
+		return result + "}\n"
+	}
+
+	types.usings["System"] = true
+	types.usings["System.Text.Json"] = true
+	types.usings["System.Text.Json.Serialization"] = true
+
+	converter := decl.Name + "JsonConverter"
+	result := fmt.Sprintf("[JsonConverter(typeof(%s))]\npublic enum %s\n{\n", converter, decl.Name)
+	for _, name := range members {
+		result += fmt.Sprintf("    %s,\n", name)
+	}
+	result += "}\n\n"
+
+	// Converts between the enum members and the values of the schema
+	var reads, writes string
+	for i, name := range members {
+		reads += fmt.Sprintf("            %s => %s.%s,\n", csString(decl.Schema.Enum[i]), decl.Name, name)
+		writes += fmt.Sprintf("            %s.%s => %s,\n", decl.Name, name, csString(decl.Schema.Enum[i]))
+	}
+	unknown := fmt.Sprintf("throw new JsonException($\"Unknown %s value: {value}\")", decl.Name)
+
+	result += fmt.Sprintf("internal sealed class %s : JsonConverter<%s>\n{\n", converter, decl.Name)
+	result += fmt.Sprintf("    public override %s Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options) =>\n", decl.Name)
+	result += "        reader.GetString() switch\n        {\n"
+	result += reads
+	result += fmt.Sprintf("            var value => %s,\n", unknown)
+	result += "        };\n\n"
+	result += fmt.Sprintf("    public override void Write(Utf8JsonWriter writer, %s value, JsonSerializerOptions options) =>\n", decl.Name)
+	result += "        writer.WriteStringValue(value switch\n        {\n"
+	result += writes
+	result += fmt.Sprintf("            _ => %s,\n", unknown)
+	result += "        });\n"
+	return result + "}\n"
+}
+
+// typeMapper converts schemas to C# types and remembers the namespaces they need
+type typeMapper struct {
+	formats   generator.FormatTable
+	aliases   map[string]ir.Schema // declarations without a C# type, replaced by their schema
+	expanding map[string]bool      // aliases being replaced, to stop at recursive ones
+	usings    map[string]bool
+}
+
+// newTypeMapper creates a typeMapper for the declarations of a service
+func newTypeMapper(decls []generator.Decl, formats generator.FormatTable) *typeMapper {
+	m := &typeMapper{
+		formats:   formats,
+		aliases:   make(map[string]ir.Schema),
+		expanding: make(map[string]bool),
+		usings:    make(map[string]bool),
+	}
+	for _, decl := range decls {
+		// Only string and integer values can be enum members
+		isEnum := decl.Kind == generator.EnumDecl && (decl.Schema.Type == "string" || decl.Schema.Type == "integer" || decl.Schema.Type == "")
+		if decl.Kind == generator.AliasDecl || (decl.Kind == generator.EnumDecl && !isEnum) {
+			aliased := decl.Schema
+			aliased.Enum = nil
+			m.aliases[decl.Name] = aliased
+		}
+	}
+	return m
+}
+
+// declared tells whether a declaration gets its own C# type
+func (m *typeMapper) declared(decl generator.Decl) bool {
+	_, isAlias := m.aliases[decl.Name]
+	return !isAlias
+}
+
+// csType converts a flattened schema to a C# type
+func (m *typeMapper) csType(schema ir.Schema) string {
+	if name, ok := generator.DeclName(schema); ok {
+		if aliased, isAlias := m.aliases[name]; isAlias {
+			// An alias containing itself (e.g. a map of itself) cannot be spelled out
+			if m.expanding[name] {
+				return "object"
+			}
+			m.expanding[name] = true
+			defer delete(m.expanding, name)
+			return m.csType(aliased)
+		}
+		return name
+	}
+
+	if ft, ok := m.formats.Lookup(schema); ok {
+		if ft.Import != "" {
+			m.usings[ft.Import] = true
+		}
+		return ft.Type
+	}
+
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "long"
+	case "number":
+		return "double"
+	case "boolean":
+		return "bool"
+	case "array":
+		m.usings["System.Collections.Generic"] = true
+		if schema.Items != nil {
+			return fmt.Sprintf("List<%s>", m.csType(*schema.Items))
+		}
+		return "List<object?>"
+	case "object", "map":
+		m.usings["System.Collections.Generic"] = true
+		if schema.AdditionalProperties != nil {
+			return fmt.Sprintf("Dictionary<string, %s>", m.csType(*schema.AdditionalProperties))
+		}
+		return "Dictionary<string, object?>"
+	}
+
+	// oneOf and unknown types can hold anything
+	return "object"
+}
+
+// fieldType returns the type of a property or parameter; optional ones are nullable
+func (m *typeMapper) fieldType(schema ir.Schema, optional bool) string {
+	csType := m.csType(schema)
+	if optional {
+		return csType + "?"
+	}
+	return csType
+}
+
+// parameterName converts a name to a camelCase C# identifier (e.g. "x-value" -> "xValue")
+func parameterName(name string) string {
+	name = generator.TypeName(name)
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	name = string(runes)
+	if reservedWords[name] {
+		name = "@" + name
+	}
+	return name
+}
+
+// enumMemberName converts an enum value to a PascalCase member (e.g. "t2.micro" -> "T2Micro", "1" -> "Value1")
+func enumMemberName(val string, index int) string {
+	name := generator.TypeName(val)
+	if name == "Type" && strings.TrimSpace(val) == "" {
+		return fmt.Sprintf("Value%d", index+1)
+	}
+	if unicode.IsDigit([]rune(val)[0]) {
+		return "Value" + strings.TrimPrefix(name, "T")
+	}
+	return name
+}
+
+// csString quotes a string as a C# string literal
+func csString(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s)
+	return `"` + s + `"`
+}
+
+// summary renders an XML doc comment
+func summary(description, note, indent string) string {
+	lines := docLines(description)
+	if note != "" {
+		lines = append(lines, xmlText(note))
+	}
+	result := indent + "/// <summary>\n"
+	for _, line := range lines {
+		result += strings.TrimRight(indent+"/// "+line, " ") + "\n"
+	}
+	return result + indent + "/// </summary>\n"
+}
+
+// docLines splits a description into XML-escaped comment lines
+func docLines(description string) []string {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return nil
+	}
+	lines := strings.Split(description, "\n")
+	for i, line := range lines {
+		lines[i] = xmlText(strings.TrimRight(line, " \t\r"))
+	}
+	return lines
+}
+
+// xmlText escapes text for XML doc comments and project files
+func xmlText(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// firstLine returns the first line of a description
+func firstLine(description string) string {
+	return strings.TrimSpace(strings.SplitN(description, "\n", 2)[0])
+}
+
+// sortedUsings returns namespaces in order, System namespaces first
+func sortedUsings(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	isSystem := func(ns string) bool { return ns == "System" || strings.HasPrefix(ns, "System.") }
+	sort.Slice(keys, func(i, j int) bool {
+		if isSystem(keys[i]) != isSystem(keys[j]) {
+			return isSystem(keys[i])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// writeFile writes a generated source file
+func writeFile(filePath, content string) error {
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", dir, err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write file %s: %v", filePath, err)
+	}
+	return nil
+}
+
+// writeProject writes the SDK-style project file of the library
+func writeProject(projectDir string, integration *ir.Integration, rootNamespace string) error {
+	version := integration.Version
+	if version == "" {
+		version = "0.0.0"
+	}
+
+	project := fmt.Sprintf(`<!-- Generated by LowCodeFusion -->
+<Project Sdk="Microsoft.NET.Sdk">
+
+  <PropertyGroup>
+    <TargetFramework>%s</TargetFramework>
+    <Nullable>enable</Nullable>
+    <ImplicitUsings>disable</ImplicitUsings>
+    <RootNamespace>%s</RootNamespace>
+    <AssemblyName>%s</AssemblyName>
+    <PackageId>%s</PackageId>
+    <Version>%s</Version>
+    <Description>Generated SDK for the Pliant %s integration</Description>
+  </PropertyGroup>
+
+</Project>
+`,
+		targetFramework,
+		xmlText(rootNamespace),
+		xmlText(rootNamespace),
+		xmlText(rootNamespace),
+		xmlText(version),
+		xmlText(integration.Name),
+	)
+
+	projectPath := filepath.Join(projectDir, rootNamespace+".csproj")
+	if err := os.WriteFile(projectPath, []byte(project), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", projectPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+// File: pkg/generator/csharp/generator_test.go
+
+package csharp
+
+import (
+	"testing"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/generator/generatortest"
+)
+
+func TestGenerateGolden(t *testing.T) {
+	generatortest.Run(t, map[string]generator.Generator{
+		"golden": &Generator{},
+	})
+}
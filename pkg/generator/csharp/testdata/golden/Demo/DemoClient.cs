@@ -0,0 +1,29 @@
+// Auto-generated C# stub for Pliant integration: Demo
+// Right now these methods only print their names.
+using System;
+using System.Collections.Generic;
+
+namespace Pliant.Sdk.Demo;
+
+/// <summary>
+/// Operations of the Demo service.
+/// </summary>
+public class DemoClient
+{
+    /// <summary>
+    /// names
+    /// </summary>
+    public string Clash(string inputs, long count, string? result = null, string? client = null, List<string>? tags = null)
+    {
+        Console.WriteLine("Function name: Clash");
+        return default!;
+    }
+
+    /// <summary>
+    /// Checks that the integration is reachable
+    /// </summary>
+    public void Ping()
+    {
+        Console.WriteLine("Function name: Ping");
+    }
+}
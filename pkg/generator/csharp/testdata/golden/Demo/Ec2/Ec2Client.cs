@@ -0,0 +1,23 @@
+// Auto-generated C# stub for Pliant integration: Demo
+// Right now these methods only print their names.
+using System;
+using Pliant.Sdk.Demo.Ec2.Models;
+
+namespace Pliant.Sdk.Demo.Ec2;
+
+/// <summary>
+/// Operations of the ec2 service.
+/// </summary>
+public class Ec2Client
+{
+    /// <summary>
+    /// Launches EC2 instances
+    /// </summary>
+    /// <param name="imageId">ID of the AMI</param>
+    /// <param name="maxCount">Number of instances</param>
+    public RunInstancesResult RunInstances(string imageId, long? maxCount = null, RunInstancesInstanceType? instanceType = null, DateTimeOffset? startAt = null, double? price = null, RunInstancesTagSpecification? tagSpecification = null)
+    {
+        Console.WriteLine("Function name: RunInstances");
+        return default!;
+    }
+}
@@ -0,0 +1,35 @@
+// Generated by LowCodeFusion
+using System;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Ec2.Models;
+
+/// <summary>
+/// From: Demo/ec2/RunInstances.json
+/// </summary>
+[JsonConverter(typeof(RunInstancesInstanceTypeJsonConverter))]
+public enum RunInstancesInstanceType
+{
+    T2Micro,
+    T3Large,
+}
+
+internal sealed class RunInstancesInstanceTypeJsonConverter : JsonConverter<RunInstancesInstanceType>
+{
+    public override RunInstancesInstanceType Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options) =>
+        reader.GetString() switch
+        {
+            "t2.micro" => RunInstancesInstanceType.T2Micro,
+            "t3.large" => RunInstancesInstanceType.T3Large,
+            var value => throw new JsonException($"Unknown RunInstancesInstanceType value: {value}"),
+        };
+
+    public override void Write(Utf8JsonWriter writer, RunInstancesInstanceType value, JsonSerializerOptions options) =>
+        writer.WriteStringValue(value switch
+        {
+            RunInstancesInstanceType.T2Micro => "t2.micro",
+            RunInstancesInstanceType.T3Large => "t3.large",
+            _ => throw new JsonException($"Unknown RunInstancesInstanceType value: {value}"),
+        });
+}
@@ -0,0 +1,19 @@
+// Generated by LowCodeFusion
+using System.Collections.Generic;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Ec2.Models;
+
+/// <summary>
+/// From: Demo/ec2/RunInstances.json
+/// </summary>
+public sealed record RunInstancesResult
+{
+    [JsonPropertyName("Instances")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public List<RunInstancesResultInstancesItem>? Instances { get; init; }
+
+    [JsonPropertyName("ReservationId")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public string? ReservationId { get; init; }
+}
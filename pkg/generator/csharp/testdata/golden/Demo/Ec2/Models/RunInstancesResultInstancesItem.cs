@@ -0,0 +1,19 @@
+// Generated by LowCodeFusion
+using System;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Ec2.Models;
+
+/// <summary>
+/// From: Demo/ec2/RunInstances.json
+/// </summary>
+public sealed record RunInstancesResultInstancesItem
+{
+    [JsonPropertyName("InstanceId")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public string? InstanceId { get; init; }
+
+    [JsonPropertyName("LaunchTime")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public DateTimeOffset? LaunchTime { get; init; }
+}
@@ -0,0 +1,18 @@
+// Generated by LowCodeFusion
+using System.Collections.Generic;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Ec2.Models;
+
+/// <summary>
+/// From: Demo/ec2/RunInstances.json
+/// </summary>
+public sealed record RunInstancesTagSpecification
+{
+    [JsonPropertyName("ResourceType")]
+    public required string ResourceType { get; init; }
+
+    [JsonPropertyName("Tags")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public List<Tag>? Tags { get; init; }
+}
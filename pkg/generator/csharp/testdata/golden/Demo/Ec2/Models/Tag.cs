@@ -0,0 +1,18 @@
+// Generated by LowCodeFusion
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Ec2.Models;
+
+/// <summary>
+/// From: Demo/ec2/RunInstances.json
+/// </summary>
+public sealed record Tag
+{
+    [JsonPropertyName("Key")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public string? Key { get; init; }
+
+    [JsonPropertyName("Value")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public string? Value { get; init; }
+}
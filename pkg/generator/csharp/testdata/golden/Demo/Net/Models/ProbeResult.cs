@@ -0,0 +1,31 @@
+// Generated by LowCodeFusion
+using System;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Net.Models;
+
+/// <summary>
+/// From: Demo/net/Probe.json
+/// </summary>
+public sealed record ProbeResult
+{
+    [JsonPropertyName("addr")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public string? Addr { get; init; }
+
+    [JsonPropertyName("day")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public DateOnly? Day { get; init; }
+
+    [JsonPropertyName("id")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public Guid? Id { get; init; }
+
+    [JsonPropertyName("latency")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public double? Latency { get; init; }
+
+    [JsonPropertyName("time")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public string? Time { get; init; }
+}
@@ -0,0 +1,21 @@
+// Auto-generated C# stub for Pliant integration: Demo
+// Right now these methods only print their names.
+using System;
+using Pliant.Sdk.Demo.Net.Models;
+
+namespace Pliant.Sdk.Demo.Net;
+
+/// <summary>
+/// Operations of the net service.
+/// </summary>
+public class NetClient
+{
+    /// <summary>
+    /// Probes a host
+    /// </summary>
+    public ProbeResult Probe(ProbeHost host, double? threshold = null)
+    {
+        Console.WriteLine("Function name: Probe");
+        return default!;
+    }
+}
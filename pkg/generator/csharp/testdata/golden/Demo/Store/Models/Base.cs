@@ -0,0 +1,17 @@
+// Generated by LowCodeFusion
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/AddPet.json
+/// </summary>
+public sealed record Base
+{
+    [JsonPropertyName("kind")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public string? Kind { get; init; }
+
+    [JsonPropertyName("name")]
+    public required string Name { get; init; }
+}
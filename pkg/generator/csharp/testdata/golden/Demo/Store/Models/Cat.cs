@@ -0,0 +1,21 @@
+// Generated by LowCodeFusion
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// A cat
+/// From: Demo/store/AddPet.json
+/// </summary>
+public sealed record Cat
+{
+    [JsonPropertyName("kind")]
+    public required CatKind Kind { get; init; }
+
+    [JsonPropertyName("lives")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public long? Lives { get; init; }
+
+    [JsonPropertyName("name")]
+    public required string Name { get; init; }
+}
@@ -0,0 +1,32 @@
+// Generated by LowCodeFusion
+using System;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/AddPet.json
+/// </summary>
+[JsonConverter(typeof(CatKindJsonConverter))]
+public enum CatKind
+{
+    Cat,
+}
+
+internal sealed class CatKindJsonConverter : JsonConverter<CatKind>
+{
+    public override CatKind Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options) =>
+        reader.GetString() switch
+        {
+            "Cat" => CatKind.Cat,
+            var value => throw new JsonException($"Unknown CatKind value: {value}"),
+        };
+
+    public override void Write(Utf8JsonWriter writer, CatKind value, JsonSerializerOptions options) =>
+        writer.WriteStringValue(value switch
+        {
+            CatKind.Cat => "Cat",
+            _ => throw new JsonException($"Unknown CatKind value: {value}"),
+        });
+}
@@ -0,0 +1,21 @@
+// Generated by LowCodeFusion
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/AddPet.json
+/// </summary>
+public sealed record Dog
+{
+    [JsonPropertyName("bark")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public object? Bark { get; init; }
+
+    [JsonPropertyName("kind")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public DogKind? Kind { get; init; }
+
+    [JsonPropertyName("name")]
+    public required string Name { get; init; }
+}
@@ -0,0 +1,32 @@
+// Generated by LowCodeFusion
+using System;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/AddPet.json
+/// </summary>
+[JsonConverter(typeof(DogKindJsonConverter))]
+public enum DogKind
+{
+    Dog,
+}
+
+internal sealed class DogKindJsonConverter : JsonConverter<DogKind>
+{
+    public override DogKind Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options) =>
+        reader.GetString() switch
+        {
+            "Dog" => DogKind.Dog,
+            var value => throw new JsonException($"Unknown DogKind value: {value}"),
+        };
+
+    public override void Write(Utf8JsonWriter writer, DogKind value, JsonSerializerOptions options) =>
+        writer.WriteStringValue(value switch
+        {
+            DogKind.Dog => "Dog",
+            _ => throw new JsonException($"Unknown DogKind value: {value}"),
+        });
+}
@@ -0,0 +1,18 @@
+// Generated by LowCodeFusion
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/AddPet.json
+/// </summary>
+public sealed record Kitten
+{
+    [JsonPropertyName("kind")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public KittenKind? Kind { get; init; }
+
+    [JsonPropertyName("lives")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public long? Lives { get; init; }
+}
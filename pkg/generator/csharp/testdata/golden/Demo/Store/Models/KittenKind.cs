@@ -0,0 +1,32 @@
+// Generated by LowCodeFusion
+using System;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/AddPet.json
+/// </summary>
+[JsonConverter(typeof(KittenKindJsonConverter))]
+public enum KittenKind
+{
+    C,
+}
+
+internal sealed class KittenKindJsonConverter : JsonConverter<KittenKind>
+{
+    public override KittenKind Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options) =>
+        reader.GetString() switch
+        {
+            "c" => KittenKind.C,
+            var value => throw new JsonException($"Unknown KittenKind value: {value}"),
+        };
+
+    public override void Write(Utf8JsonWriter writer, KittenKind value, JsonSerializerOptions options) =>
+        writer.WriteStringValue(value switch
+        {
+            KittenKind.C => "c",
+            _ => throw new JsonException($"Unknown KittenKind value: {value}"),
+        });
+}
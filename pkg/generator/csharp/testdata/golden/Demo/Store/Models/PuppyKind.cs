@@ -0,0 +1,32 @@
+// Generated by LowCodeFusion
+using System;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/AddPet.json
+/// </summary>
+[JsonConverter(typeof(PuppyKindJsonConverter))]
+public enum PuppyKind
+{
+    D,
+}
+
+internal sealed class PuppyKindJsonConverter : JsonConverter<PuppyKind>
+{
+    public override PuppyKind Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options) =>
+        reader.GetString() switch
+        {
+            "d" => PuppyKind.D,
+            var value => throw new JsonException($"Unknown PuppyKind value: {value}"),
+        };
+
+    public override void Write(Utf8JsonWriter writer, PuppyKind value, JsonSerializerOptions options) =>
+        writer.WriteStringValue(value switch
+        {
+            PuppyKind.D => "d",
+            _ => throw new JsonException($"Unknown PuppyKind value: {value}"),
+        });
+}
@@ -0,0 +1,13 @@
+// Generated by LowCodeFusion
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/Put.json
+/// </summary>
+public sealed record PutConfig
+{
+    [JsonPropertyName("name")]
+    public required string Name { get; init; }
+}
@@ -0,0 +1,15 @@
+// Generated by LowCodeFusion
+using System.Collections.Generic;
+using System.Text.Json.Serialization;
+
+namespace Pliant.Sdk.Demo.Store.Models;
+
+/// <summary>
+/// From: Demo/store/Put.json
+/// </summary>
+public sealed record PutNested
+{
+    [JsonPropertyName("m")]
+    [JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+    public Dictionary<string, List<string>>? M { get; init; }
+}
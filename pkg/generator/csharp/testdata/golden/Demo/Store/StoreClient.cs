@@ -0,0 +1,31 @@
+// Auto-generated C# stub for Pliant integration: Demo
+// Right now these methods only print their names.
+using System;
+using System.Collections.Generic;
+using Pliant.Sdk.Demo.Store.Models;
+
+namespace Pliant.Sdk.Demo.Store;
+
+/// <summary>
+/// Operations of the store service.
+/// </summary>
+public class StoreClient
+{
+    /// <summary>
+    /// Adds a pet
+    /// </summary>
+    public object AddPet(object pet, object? tags = null)
+    {
+        Console.WriteLine("Function name: AddPet");
+        return default!;
+    }
+
+    /// <summary>
+    /// Stores settings
+    /// </summary>
+    public Dictionary<string, PutResultValue> Put(Dictionary<string, string> labels, PutConfig config, Dictionary<string, object>? counts = null, Dictionary<string, object>? tree = null, PutNested? nested = null, Dictionary<string, object?>? anything = null)
+    {
+        Console.WriteLine("Function name: Put");
+        return default!;
+    }
+}
@@ -0,0 +1,86 @@
+// File: pkg/generator/formats.go
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// FormatType is the target-language type of a JSON Schema "format"
+type FormatType struct {
+	Type     string // type as written in code (e.g. "UUID", "time.Time")
+	Import   string // what the type is imported from, empty if it needs no import
+	JSONType string // JSON type the format applies to (e.g. "string"), empty for any
+}
+
+// FormatTable maps JSON Schema formats (e.g. "uuid") to target-language types.
+// It implements pflag.Value, so a generator can take overrides from a
+// --<lang>-format flag with values like "uuid=UUID@uuid" (format=Type[@import]).
+type FormatTable map[string]FormatType
+
+// Lookup returns the type of a schema's format, if the table maps it for the schema's JSON type
+func (t FormatTable) Lookup(s ir.Schema) (FormatType, bool) {
+	if s.Format == "" {
+		return FormatType{}, false
+	}
+	ft, ok := t[s.Format]
+	if !ok || (ft.JSONType != "" && ft.JSONType != s.Type) {
+		return FormatType{}, false
+	}
+	return ft, true
+}
+
+// Clone returns a copy of the table that overrides can be applied to
+func (t FormatTable) Clone() FormatTable {
+	clone := make(FormatTable, len(t))
+	for format, ft := range t {
+		clone[format] = ft
+	}
+	return clone
+}
+
+// Formats returns the formats in the table, sorted
+func (t FormatTable) Formats() []string {
+	formats := make([]string, 0, len(t))
+	for format := range t {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// String lists the table as format=Type[@import] entries
+func (t FormatTable) String() string {
+	entries := make([]string, 0, len(t))
+	for _, format := range t.Formats() {
+		entry := format + "=" + t[format].Type
+		if t[format].Import != "" {
+			entry += "@" + t[format].Import
+		}
+		entries = append(entries, entry)
+	}
+	return strings.Join(entries, ",")
+}
+
+// Set applies format=Type[@import] overrides, separated by commas.
+// An override keeps the JSON type of the entry it replaces.
+func (t FormatTable) Set(value string) error {
+	for _, entry := range strings.Split(value, ",") {
+		format, typeSpec, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || format == "" || typeSpec == "" {
+			return fmt.Errorf("invalid format mapping %q (expected format=Type or format=Type@import)", entry)
+		}
+		typeName, importPath, _ := strings.Cut(typeSpec, "@")
+		t[format] = FormatType{Type: typeName, Import: importPath, JSONType: t[format].JSONType}
+	}
+	return nil
+}
+
+// Type names the flag value in help output
+func (t FormatTable) Type() string {
+	return "format=type"
+}
@@ -0,0 +1,60 @@
+// File: pkg/generator/formats_test.go
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+func TestFormatTableLookup(t *testing.T) {
+	table := FormatTable{
+		"uuid":  {Type: "UUID", Import: "uuid", JSONType: "string"},
+		"int64": {Type: "int64"},
+	}
+	tests := []struct {
+		schema ir.Schema
+		want   string // empty if the format is not mapped
+	}{
+		{ir.Schema{Type: "string", Format: "uuid"}, "UUID"},
+		{ir.Schema{Type: "integer", Format: "uuid"}, ""},
+		{ir.Schema{Type: "integer", Format: "int64"}, "int64"},
+		{ir.Schema{Type: "string", Format: "int64"}, "int64"},
+		{ir.Schema{Type: "string", Format: "email"}, ""},
+		{ir.Schema{Type: "string"}, ""},
+	}
+	for _, tt := range tests {
+		ft, ok := table.Lookup(tt.schema)
+		if ok != (tt.want != "") || ft.Type != tt.want {
+			t.Errorf("Lookup(%s/%s) = %q, %v, want %q", tt.schema.Type, tt.schema.Format, ft.Type, ok, tt.want)
+		}
+	}
+}
+
+func TestFormatTableSet(t *testing.T) {
+	defaults := FormatTable{"uuid": {Type: "UUID", Import: "uuid", JSONType: "string"}}
+	table := defaults.Clone()
+
+	if err := table.Set("uuid=str, decimal=Decimal@decimal"); err != nil {
+		t.Fatal(err)
+	}
+	if got := table["uuid"]; got != (FormatType{Type: "str", JSONType: "string"}) {
+		t.Errorf("uuid = %+v, want str keeping the JSON type", got)
+	}
+	if got := table["decimal"]; got != (FormatType{Type: "Decimal", Import: "decimal"}) {
+		t.Errorf("decimal = %+v", got)
+	}
+	if got, want := table.String(), "decimal=Decimal@decimal,uuid=str"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+	if defaults["uuid"].Type != "UUID" {
+		t.Error("Set changed the table the clone was made from")
+	}
+
+	for _, value := range []string{"uuid", "=UUID", "uuid=", "uuid=UUID,,"} {
+		if err := table.Clone().Set(value); err == nil {
+			t.Errorf("Set(%q) should fail", value)
+		}
+	}
+}
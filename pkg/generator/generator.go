@@ -0,0 +1,143 @@
+// File: pkg/generator/generator.go
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Capability names an optional feature a generator supports
+type Capability string
+
+const (
+	TypedModels Capability = "types"   // typed models for input and output schemas
+	Runtime     Capability = "runtime" // operations invoke flows on the Pliant server
+	Async       Capability = "async"   // asynchronous operation variants
+)
+
+// Generator renders an integration in a target language.
+// Targets register themselves with Register from an init function.
+type Generator interface {
+	// Name is the value of --lang selecting the generator (e.g. "python")
+	Name() string
+	// Description is a one-line summary shown by `lcf langs`
+	Description() string
+	// Capabilities lists the optional features the generator supports
+	Capabilities() []Capability
+	// Flags registers generator-specific flags, named with the generator's prefix (e.g. --python-async).
+	// It is called once by Register; flags asking for a capability are marked with MarkCapability.
+	Flags(fs *pflag.FlagSet)
+	// Generate writes the SDK for an integration into outDir
+	Generate(integration *ir.Integration, outDir string) error
+}
+
+// capabilityAnnotation is the flag annotation naming the capability a flag asks for
+const capabilityAnnotation = "lcf-capability"
+
+var (
+	mu         sync.RWMutex
+	generators = make(map[string]Generator)
+	flagSets   = make(map[string]*pflag.FlagSet)
+)
+
+// Register makes a generator available by name and binds its flags.
+// It panics if the name is taken.
+func Register(g Generator) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	name := strings.ToLower(g.Name())
+	if _, exists := generators[name]; exists {
+		panic(fmt.Sprintf("generator: %s registered twice", name))
+	}
+	generators[name] = g
+
+	fs := pflag.NewFlagSet(name, pflag.ContinueOnError)
+	g.Flags(fs)
+	flagSets[name] = fs
+}
+
+// FlagSet returns the flags of a registered generator. Commands add it to their own
+// flags; the flags stay bound to the generator's options.
+func FlagSet(g Generator) *pflag.FlagSet {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if fs, ok := flagSets[strings.ToLower(g.Name())]; ok {
+		return fs
+	}
+	return pflag.NewFlagSet(g.Name(), pflag.ContinueOnError)
+}
+
+// MarkCapability records that setting a flag asks for a capability (e.g. --python-async for Async)
+func MarkCapability(fs *pflag.FlagSet, name string, c Capability) {
+	if err := fs.SetAnnotation(name, capabilityAnnotation, []string{string(c)}); err != nil {
+		panic(fmt.Sprintf("generator: %v", err))
+	}
+}
+
+// FlagCapability returns the capability a flag asks for, or "" if it asks for none
+func FlagCapability(f *pflag.Flag) Capability {
+	if values := f.Annotations[capabilityAnnotation]; len(values) > 0 {
+		return Capability(values[0])
+	}
+	return ""
+}
+
+// Get returns the generator registered under name
+func Get(name string) (Generator, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	g, ok := generators[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported language: %s (available: %s)", name, strings.Join(namesLocked(), ", "))
+	}
+	return g, nil
+}
+
+// All returns the registered generators sorted by name
+func All() []Generator {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var all []Generator
+	for _, name := range namesLocked() {
+		all = append(all, generators[name])
+	}
+	return all
+}
+
+// Names returns the registered generator names, sorted
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return namesLocked()
+}
+
+// namesLocked lists the generator names; the caller holds mu
+func namesLocked() []string {
+	names := make([]string, 0, len(generators))
+	for name := range generators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Supports reports whether a generator has a capability
+func Supports(g Generator, c Capability) bool {
+	for _, have := range g.Capabilities() {
+		if have == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// File: pkg/generator/generator_test.go
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// fakeGenerator counts how often its flags are registered
+type fakeGenerator struct {
+	async      bool
+	flagsCalls int
+}
+
+func (g *fakeGenerator) Name() string                           { return "Fake" }
+func (g *fakeGenerator) Description() string                    { return "test generator" }
+func (g *fakeGenerator) Capabilities() []Capability             { return []Capability{TypedModels} }
+func (g *fakeGenerator) Generate(*ir.Integration, string) error { return nil }
+
+func (g *fakeGenerator) Flags(fs *pflag.FlagSet) {
+	g.flagsCalls++
+	fs.BoolVar(&g.async, "fake-async", false, "async variants")
+	fs.String("fake-name", "", "package name")
+	MarkCapability(fs, "fake-async", Async)
+}
+
+func TestRegister(t *testing.T) {
+	g := &fakeGenerator{}
+	Register(g)
+	t.Cleanup(func() {
+		mu.Lock()
+		delete(generators, "fake")
+		delete(flagSets, "fake")
+		mu.Unlock()
+	})
+
+	if got, err := Get("FAKE"); err != nil || got != g {
+		t.Errorf("Get(FAKE) = %v, %v", got, err)
+	}
+	if !Supports(g, TypedModels) || Supports(g, Async) {
+		t.Errorf("Supports does not match Capabilities %v", g.Capabilities())
+	}
+
+	// The flags are bound once and shared with every caller
+	fs := FlagSet(g)
+	if FlagSet(g) != fs || g.flagsCalls != 1 {
+		t.Errorf("flags registered %d times", g.flagsCalls)
+	}
+	if err := fs.Set("fake-async", "true"); err != nil || !g.async {
+		t.Errorf("--fake-async is not bound to the generator: %v", err)
+	}
+	if c := FlagCapability(fs.Lookup("fake-async")); c != Async {
+		t.Errorf("FlagCapability(--fake-async) = %q, want async", c)
+	}
+	if c := FlagCapability(fs.Lookup("fake-name")); c != "" {
+		t.Errorf("FlagCapability(--fake-name) = %q, want none", c)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a name twice should panic")
+		}
+	}()
+	Register(&fakeGenerator{})
+}
@@ -0,0 +1,157 @@
+// File: pkg/generator/generatortest/golden.go
+
+// Package generatortest runs generators against a shared fixture integration
+// and compares their output with golden files.
+package generatortest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+const (
+	// updateEnv set to a non-empty value rewrites the golden files instead of comparing against them
+	updateEnv = "LCF_UPDATE_GOLDEN"
+	// repoRoot is the repository root relative to a generator package, where the templates live
+	repoRoot = "../../.."
+	// fixtureDir holds the extracted fixture package, relative to the repository root
+	fixtureDir = "pkg/generator/testdata"
+)
+
+// Run runs Golden in a subtest for every generator, named after its golden directory
+func Run(t *testing.T, generators map[string]generator.Generator) {
+	t.Helper()
+	names := make([]string, 0, len(generators))
+	for name := range generators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g := generators[name]
+		t.Run(name, func(t *testing.T) {
+			Golden(t, name, g.Generate)
+		})
+	}
+}
+
+// Golden generates the fixture integration "Demo" and compares every file written to
+// outDir with testdata/<name> of the calling package. Set LCF_UPDATE_GOLDEN=1 to rewrite them.
+func Golden(t *testing.T, name string, generate func(integration *ir.Integration, outDir string) error) {
+	t.Helper()
+	pkgDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	goldenDir := filepath.Join(pkgDir, "testdata", name)
+
+	// Generators read their templates relative to the repository root
+	if err := os.Chdir(repoRoot); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(pkgDir) })
+
+	integration, err := ir.Load(fixtureDir, "Demo")
+	if err != nil {
+		t.Fatalf("failed to load the fixture: %v", err)
+	}
+	integration.Version = "1.0.0"
+
+	outDir := t.TempDir()
+	if err := generate(integration, outDir); err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	got := readTree(t, outDir)
+
+	if os.Getenv(updateEnv) != "" {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		for rel, content := range got {
+			path := filepath.Join(goldenDir, filepath.FromSlash(rel))
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	want := readTree(t, goldenDir)
+	for _, rel := range sortedKeys(want) {
+		content, ok := got[rel]
+		switch {
+		case !ok:
+			t.Errorf("%s was not generated", rel)
+		case content != want[rel]:
+			t.Errorf("%s differs from the golden file:\n%s", rel, firstDifference(want[rel], content))
+		}
+	}
+	for _, rel := range sortedKeys(got) {
+		if _, ok := want[rel]; !ok {
+			t.Errorf("%s has no golden file", rel)
+		}
+	}
+}
+
+// readTree returns the contents of every file below dir by slash-separated relative path
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to read %s (set %s=1 to create the golden files): %v", dir, updateEnv, err)
+	}
+	return files
+}
+
+// firstDifference shows the first line that differs between two files
+func firstDifference(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n  want: %s\n  got:  %s", i+1, w, g)
+		}
+	}
+	return ""
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
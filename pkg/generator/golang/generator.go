@@ -0,0 +1,447 @@
+// File: pkg/generator/golang/generator.go
+
+package golang
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Operation is the template view of an operation function
+type Operation struct {
+	Name       string      // exported function name (e.g. "RunInstances")
+	Doc        []string    // lines of the doc comment
+	Params     []Parameter // in flow order
+	ResultType string      // empty if the flow has no output
+}
+
+// Parameter is a function parameter
+type Parameter struct {
+	Name string
+	Type string
+}
+
+// Options are the Go-specific generator settings
+type Options struct {
+	ModulePath string                // module path of the generated go.mod, derived from the integration if empty
+	Formats    generator.FormatTable // JSON Schema format -> Go type, DefaultFormats if nil
+}
+
+// DefaultFormats maps JSON Schema formats to Go types; other formats stay strings
+var DefaultFormats = generator.FormatTable{
+	"date-time": {Type: "time.Time", Import: "time", JSONType: "string"},
+	"byte":      {Type: "[]byte", JSONType: "string"}, // encoding/json uses base64 for []byte
+	"int32":     {Type: "int32", JSONType: "integer"},
+	"int64":     {Type: "int64", JSONType: "integer"},
+	"float":     {Type: "float32", JSONType: "number"},
+	"double":    {Type: "float64", JSONType: "number"},
+}
+
+// reservedWords cannot be used as parameter names
+var reservedWords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true,
+	"goto": true, "if": true, "import": true, "interface": true, "map": true, "package": true,
+	"range": true, "return": true, "select": true, "struct": true, "switch": true, "type": true,
+	"var": true,
+	// imported by the generated files
+	"fmt": true, "time": true, "result": true,
+}
+
+// Generate writes a Go module for an integration with one package per service:
+//
+//	<out>/<integration>/go.mod
+//	<out>/<integration>/<service>/common_types.go      types shared within the service
+//	<out>/<integration>/<service>/<Operation>_types.go types of one operation
+//	<out>/<integration>/<service>/<Operation>.go       one function per operation
+func Generate(integration *ir.Integration, outDir string, opts Options) error {
+	moduleDir := filepath.Join(outDir, integration.Name)
+	if err := os.MkdirAll(moduleDir, 0755); err != nil {
+		return fmt.Errorf("failed to create module directory %s: %v", moduleDir, err)
+	}
+	fmt.Printf("Module directory: %s\n", moduleDir)
+
+	formats := opts.Formats
+	if formats == nil {
+		formats = DefaultFormats
+	}
+
+	tmpl, err := loadTemplate()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Generating Go packages:")
+	count := 0
+	for _, service := range integration.Services {
+		model := generator.NewServiceModel(service)
+
+		// Operations directly in the integration directory go to the root package
+		pkgName := packageName(service.Name)
+		pkgDir := filepath.Join(moduleDir, pkgName)
+		if service.Name == "" {
+			pkgName = packageName(integration.Name)
+			pkgDir = moduleDir
+		}
+		fmt.Printf("- Package: %s\n", pkgName)
+
+		// Type names and function names share the package scope
+		names := make(map[string]bool)
+		for _, decl := range model.Decls() {
+			names[decl.Name] = true
+		}
+
+		if err := writeTypesFile(filepath.Join(pkgDir, "common_types.go"), pkgName, model.Common, formats); err != nil {
+			return err
+		}
+
+		for _, op := range model.Operations {
+			funcName := generator.TypeName(op.Operation.Name)
+			for names[funcName] {
+				funcName += "Operation"
+			}
+			names[funcName] = true
+
+			if err := writeTypesFile(filepath.Join(pkgDir, funcName+"_types.go"), pkgName, op.Decls, formats); err != nil {
+				return err
+			}
+
+			opPath := filepath.Join(pkgDir, funcName+".go")
+			if err := writeOperation(tmpl, opPath, pkgName, funcName, integration.Name, op, formats); err != nil {
+				return err
+			}
+			count++
+
+			fmt.Printf("  - Generated: %s\n", opPath)
+		}
+	}
+
+	// Write go.mod
+	modulePath := opts.ModulePath
+	if modulePath == "" {
+		modulePath = packageName(integration.Name)
+	}
+	goMod := fmt.Sprintf("module %s\n\ngo 1.21\n", modulePath)
+	if err := os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte(goMod), 0644); err != nil {
+		return fmt.Errorf("failed to write go.mod: %v", err)
+	}
+
+	fmt.Printf("\nSuccessfully generated %d Go functions\n", count)
+	return nil
+}
+
+// loadTemplate reads the operation function template
+func loadTemplate() (*template.Template, error) {
+	tmplPath := "templates/go_func.tmpl"
+	tmplContent, err := os.ReadFile(tmplPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file %s: %v", tmplPath, err)
+	}
+
+	tmpl, err := template.New("go_func").Parse(string(tmplContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// writeOperation renders the function of one operation
+func writeOperation(
+	tmpl *template.Template,
+	filePath, pkgName, funcName, integrationName string,
+	op *generator.OperationModel,
+	formats generator.FormatTable,
+) error {
+	types := newTypeMapper(formats)
+	view := Operation{
+		Name: funcName,
+		Doc:  docLines(funcName, op.Operation.Description),
+	}
+
+	usedNames := make(map[string]bool)
+	for _, input := range op.Inputs {
+		name := paramName(input.Name)
+		for usedNames[name] {
+			name += "_"
+		}
+		usedNames[name] = true
+
+		view.Params = append(view.Params, Parameter{
+			Name: name,
+			Type: types.fieldType(input.Schema, !input.Required, ""),
+		})
+	}
+	if op.Output != nil {
+		view.ResultType = types.goType(op.Output.Schema)
+	}
+
+	data := struct {
+		Integration string
+		Package     string
+		Op          Operation
+	}{
+		Integration: integrationName,
+		Package:     pkgName,
+		Op:          view,
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return fmt.Errorf("failed to execute template: %v", err)
+	}
+
+	// Signatures may use types of other packages, such as time.Time
+	content := buffer.String()
+	types.imports["fmt"] = true
+	content = strings.Replace(content, "import \"fmt\"\n", types.importDecl(), 1)
+	return writeGoFile(filePath, content)
+}
+
+// writeTypesFile writes the structs, enums and named types for declarations
+func writeTypesFile(filePath, pkgName string, decls []generator.Decl, formats generator.FormatTable) error {
+	types := newTypeMapper(formats)
+
+	body := ""
+	for _, decl := range decls {
+		body += "\n"
+		body += goDecl(decl, types)
+	}
+
+	content := "// Code generated by LowCodeFusion. DO NOT EDIT.\n\n"
+	content += fmt.Sprintf("package %s\n", pkgName)
+	if len(types.imports) > 0 {
+		content += "\n" + types.importDecl()
+	}
+	content += body
+
+	return writeGoFile(filePath, content)
+}
+
+// goDecl renders a declaration
+func goDecl(decl generator.Decl, types *typeMapper) string {
+	result := ""
+	for _, line := range docLines(decl.Name, decl.Description) {
+		result += strings.TrimRight("// "+line, " ") + "\n"
+	}
+	result += fmt.Sprintf("//\n// Generated from %s.\n", decl.Source)
+
+	switch decl.Kind {
+	case generator.StructDecl:
+		result += fmt.Sprintf("type %s struct {\n", decl.Name)
+		fieldNames := make(map[string]bool)
+		for _, propName := range decl.Schema.PropertyNames() {
+			prop := decl.Schema.Properties[propName]
+
+			fieldName := generator.TypeName(propName)
+			for fieldNames[fieldName] {
+				fieldName += "_"
+			}
+			fieldNames[fieldName] = true
+
+			required := decl.Schema.IsRequired(propName)
+			tag := propName
+			if !required {
+				tag += ",omitempty"
+			}
+			for _, line := range commentLines(prop.Description) {
+				result += "\t// " + line + "\n"
+			}
+			result += fmt.Sprintf("\t%s %s `json:%q`\n", fieldName, types.fieldType(prop, !required, decl.Name), tag)
+		}
+		return result + "}\n"
+
+	case generator.EnumDecl:
+		base := types.goType(ir.Schema{Type: decl.Schema.Type})
+		if base == "interface{}" {
+			base = "string"
+		}
+		result += fmt.Sprintf("type %s %s\n\n", decl.Name, base)
+		result += fmt.Sprintf("// Values of %s\nconst (\n", decl.Name)
+		constNames := make(map[string]bool)
+		for i, val := range decl.Schema.Enum {
+			// The type name already makes the constant a valid identifier (e.g. DeepOpLevel1)
+			suffix := strings.TrimPrefix(generator.TypeName(val), "T")
+			if generator.TypeName(val) == "Type" {
+				suffix = fmt.Sprintf("Value%d", i+1)
+			} else if !unicode.IsDigit([]rune(val)[0]) {
+				suffix = generator.TypeName(val)
+			}
+			constName := decl.Name + suffix
+			for constNames[constName] {
+				constName += "_"
+			}
+			constNames[constName] = true
+
+			literal := fmt.Sprintf("%q", val)
+			if base != "string" {
+				literal = val
+			}
+			result += fmt.Sprintf("\t%s %s = %s\n", constName, decl.Name, literal)
+		}
+		return result + ")\n"
+
+	default:
+		return result + fmt.Sprintf("type %s %s\n", decl.Name, types.goType(decl.Schema))
+	}
+}
+
+// typeMapper converts schemas to Go types and remembers the packages they need
+type typeMapper struct {
+	formats generator.FormatTable
+	imports map[string]bool
+}
+
+// newTypeMapper creates a typeMapper without imports
+func newTypeMapper(formats generator.FormatTable) *typeMapper {
+	return &typeMapper{formats: formats, imports: make(map[string]bool)}
+}
+
+// importDecl renders the import declaration of the packages used
+func (m *typeMapper) importDecl() string {
+	packages := make([]string, 0, len(m.imports))
+	for pkg := range m.imports {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+
+	if len(packages) == 1 {
+		return fmt.Sprintf("import %q\n", packages[0])
+	}
+	result := "import (\n"
+	for _, pkg := range packages {
+		result += fmt.Sprintf("\t%q\n", pkg)
+	}
+	return result + ")\n"
+}
+
+// goType converts a flattened schema to a Go type
+func (m *typeMapper) goType(schema ir.Schema) string {
+	if name, ok := generator.DeclName(schema); ok {
+		return name
+	}
+
+	if ft, ok := m.formats.Lookup(schema); ok {
+		if ft.Import != "" {
+			m.imports[ft.Import] = true
+		}
+		return ft.Type
+	}
+
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if schema.Items != nil {
+			return "[]" + m.goType(*schema.Items)
+		}
+		return "[]interface{}"
+	case "object", "map":
+		if schema.AdditionalProperties != nil {
+			return "map[string]" + m.goType(*schema.AdditionalProperties)
+		}
+		return "map[string]interface{}"
+	}
+
+	// oneOf and unknown types can hold anything
+	return "interface{}"
+}
+
+// fieldType returns the type of a field or parameter; optional values that have no nil
+// are pointers, as are fields of a struct that refer to the struct itself
+func (m *typeMapper) fieldType(schema ir.Schema, optional bool, owner string) string {
+	goType := m.goType(schema)
+	if strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") || goType == "interface{}" {
+		return goType
+	}
+	if optional || (owner != "" && goType == owner) {
+		return "*" + goType
+	}
+	return goType
+}
+
+// docLines builds a doc comment that starts with the documented name
+func docLines(name, description string) []string {
+	lines := commentLines(description)
+	if len(lines) == 0 {
+		return []string{name + " is generated from a Pliant flow."}
+	}
+	// "Launches instances" -> "RunInstances launches instances", acronyms stay as they are
+	first := []rune(lines[0])
+	if len(first) > 1 && unicode.IsLower(first[1]) {
+		first[0] = unicode.ToLower(first[0])
+	}
+	lines[0] = name + " " + string(first)
+	return lines
+}
+
+// commentLines splits a description into comment lines
+func commentLines(description string) []string {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return nil
+	}
+	lines := strings.Split(description, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return lines
+}
+
+// packageName converts a service or integration name to a Go package name (e.g. "Active Directory" -> "activedirectory")
+func packageName(name string) string {
+	re := regexp.MustCompile(`[^a-z0-9]`)
+	pkg := re.ReplaceAllString(strings.ToLower(name), "")
+	if pkg == "" || unicode.IsDigit(rune(pkg[0])) {
+		pkg = "p" + pkg
+	}
+	if reservedWords[pkg] {
+		pkg += "pkg"
+	}
+	return pkg
+}
+
+// paramName converts a variable name to an unexported Go identifier (e.g. "x-value" -> "xValue")
+func paramName(name string) string {
+	name = generator.TypeName(name)
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	name = string(runes)
+	if reservedWords[name] {
+		name += "_"
+	}
+	return name
+}
+
+// writeGoFile formats Go source and writes it
+func writeGoFile(filePath, content string) error {
+	source, err := format.Source([]byte(content))
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %v", filePath, err)
+	}
+
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", dir, err)
+	}
+	if err := os.WriteFile(filePath, source, 0644); err != nil {
+		return fmt.Errorf("failed to write file %s: %v", filePath, err)
+	}
+	return nil
+}
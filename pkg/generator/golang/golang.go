@@ -0,0 +1,45 @@
+// File: pkg/generator/golang/golang.go
+
+package golang
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Generator is the Go target of lcf generate --lang go
+type Generator struct {
+	opts Options
+}
+
+func init() {
+	generator.Register(&Generator{opts: Options{Formats: DefaultFormats.Clone()}})
+}
+
+// Name selects the generator with --lang
+func (g *Generator) Name() string {
+	return "go"
+}
+
+// Description summarizes the generated SDK
+func (g *Generator) Description() string {
+	return "Go module with a package per service and typed structs"
+}
+
+// Capabilities lists the optional features of the Go SDK
+func (g *Generator) Capabilities() []generator.Capability {
+	return []generator.Capability{generator.TypedModels}
+}
+
+// Flags registers the Go-specific flags
+func (g *Generator) Flags(fs *pflag.FlagSet) {
+	fs.StringVarP(&g.opts.ModulePath, "go-module", "", "", "Module path of the Go SDK (default derived from the integration)")
+	fs.Var(g.opts.Formats, "go-format", "Go type of a JSON Schema format, as format=Type[@import] (repeatable)")
+}
+
+// Generate writes the Go SDK for an integration
+func (g *Generator) Generate(integration *ir.Integration, outDir string) error {
+	return Generate(integration, outDir, g.opts)
+}
@@ -0,0 +1,13 @@
+// Auto-generated Go stub for Pliant integration: Demo
+// Right now this function only prints its name.
+
+package demo
+
+import "fmt"
+
+// Clash names
+func Clash(inputs string, result_ *string, client *string, count int64, tags *ClashTags) (string, error) {
+	fmt.Println("Function name: Clash")
+	var result string
+	return result, nil
+}
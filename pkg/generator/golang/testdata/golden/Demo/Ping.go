@@ -0,0 +1,12 @@
+// Auto-generated Go stub for Pliant integration: Demo
+// Right now this function only prints its name.
+
+package demo
+
+import "fmt"
+
+// Ping checks that the integration is reachable
+func Ping() error {
+	fmt.Println("Function name: Ping")
+	return nil
+}
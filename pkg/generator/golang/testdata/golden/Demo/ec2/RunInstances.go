@@ -0,0 +1,16 @@
+// Auto-generated Go stub for Pliant integration: Demo
+// Right now this function only prints its name.
+
+package ec2
+
+import (
+	"fmt"
+	"time"
+)
+
+// RunInstances launches EC2 instances
+func RunInstances(imageId string, maxCount *int64, instanceType *RunInstancesInstanceType, startAt *time.Time, price *float64, tagSpecification *RunInstancesTagSpecification) (RunInstancesResult, error) {
+	fmt.Println("Function name: RunInstances")
+	var result RunInstancesResult
+	return result, nil
+}
@@ -0,0 +1,13 @@
+// Auto-generated Go stub for Pliant integration: Demo
+// Right now this function only prints its name.
+
+package net
+
+import "fmt"
+
+// Probe probes a host
+func Probe(host ProbeHost, threshold *float64) (ProbeResult, error) {
+	fmt.Println("Function name: Probe")
+	var result ProbeResult
+	return result, nil
+}
@@ -0,0 +1,13 @@
+// Auto-generated Go stub for Pliant integration: Demo
+// Right now this function only prints its name.
+
+package store
+
+import "fmt"
+
+// AddPet adds a pet
+func AddPet(pet AddPetPet, tags interface{}) (AddPetResult, error) {
+	fmt.Println("Function name: AddPet")
+	var result AddPetResult
+	return result, nil
+}
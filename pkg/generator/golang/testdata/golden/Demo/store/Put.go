@@ -0,0 +1,13 @@
+// Auto-generated Go stub for Pliant integration: Demo
+// Right now this function only prints its name.
+
+package store
+
+import "fmt"
+
+// Put stores settings
+func Put(labels PutLabels, counts *PutCounts, config PutConfig, tree *Tree, nested *PutNested, anything *PutAnything) (PutResult, error) {
+	fmt.Println("Function name: Put")
+	var result PutResult
+	return result, nil
+}
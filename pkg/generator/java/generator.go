@@ -0,0 +1,594 @@
+// File: pkg/generator/java/generator.go
+
+package java
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Operation is the template view of a client method
+type Operation struct {
+	Name       string      // method name (e.g. "runInstances")
+	FlowName   string      // flow name (e.g. "RunInstances")
+	Doc        []string    // lines of the Javadoc comment
+	Params     []Parameter // in flow order
+	ReturnType string      // "void" if the flow has no output
+}
+
+// Parameter is a method parameter
+type Parameter struct {
+	Name string
+	Type string
+}
+
+// Options are the Java-specific generator settings
+type Options struct {
+	Package string                // base Java package, also the Maven groupId
+	Formats generator.FormatTable // JSON Schema format -> Java type, DefaultFormats if nil
+}
+
+// DefaultFormats maps JSON Schema formats to Java types; other formats stay strings
+var DefaultFormats = generator.FormatTable{
+	"date-time": {Type: "OffsetDateTime", Import: "java.time.OffsetDateTime", JSONType: "string"},
+	"date":      {Type: "LocalDate", Import: "java.time.LocalDate", JSONType: "string"},
+	"time":      {Type: "OffsetTime", Import: "java.time.OffsetTime", JSONType: "string"},
+	"uuid":      {Type: "UUID", Import: "java.util.UUID", JSONType: "string"},
+	"uri":       {Type: "URI", Import: "java.net.URI", JSONType: "string"},
+	"ipv4":      {Type: "InetAddress", Import: "java.net.InetAddress", JSONType: "string"},
+	"ipv6":      {Type: "InetAddress", Import: "java.net.InetAddress", JSONType: "string"},
+	"byte":      {Type: "byte[]", JSONType: "string"}, // Jackson uses base64 for byte[]
+	"int32":     {Type: "Integer", JSONType: "integer"},
+	"int64":     {Type: "Long", JSONType: "integer"},
+	"float":     {Type: "Float", JSONType: "number"},
+	"double":    {Type: "Double", JSONType: "number"},
+}
+
+// Jackson annotations used by the generated model classes
+const jacksonVersion = "2.17.0"
+
+// reservedWords cannot be used as identifiers
+var reservedWords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true, "case": true,
+	"catch": true, "char": true, "class": true, "const": true, "continue": true, "default": true,
+	"do": true, "double": true, "else": true, "enum": true, "extends": true, "final": true,
+	"finally": true, "float": true, "for": true, "goto": true, "if": true, "implements": true,
+	"import": true, "instanceof": true, "int": true, "interface": true, "long": true, "native": true,
+	"new": true, "package": true, "private": true, "protected": true, "public": true, "return": true,
+	"short": true, "static": true, "strictfp": true, "super": true, "switch": true, "synchronized": true,
+	"this": true, "throw": true, "throws": true, "transient": true, "try": true, "void": true,
+	"volatile": true, "while": true, "true": true, "false": true, "null": true, "record": true,
+	"var": true, "yield": true, "_": true,
+}
+
+// Generate writes a Maven project for an integration:
+//
+//	<out>/<integration>/pom.xml
+//	<out>/<integration>/src/main/java/<package>/<service>/<Service>Client.java  one method per operation
+//	<out>/<integration>/src/main/java/<package>/<service>/model/*.java          records and enums of the service
+func Generate(integration *ir.Integration, outDir string, opts Options) error {
+	projectDir := filepath.Join(outDir, integration.Name)
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		return fmt.Errorf("failed to create project directory %s: %v", projectDir, err)
+	}
+	fmt.Printf("Project directory: %s\n", projectDir)
+
+	formats := opts.Formats
+	if formats == nil {
+		formats = DefaultFormats
+	}
+
+	basePackage := opts.Package
+	if basePackage == "" {
+		basePackage = "com.pliant.sdk." + packageSegment(integration.Name)
+	}
+	srcDir := filepath.Join(projectDir, "src", "main", "java")
+
+	tmpl, err := loadTemplate()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Generating Java classes:")
+	count := 0
+	for _, service := range integration.Services {
+		model := generator.NewServiceModel(service)
+
+		// Operations directly in the integration directory go to the base package
+		javaPackage := basePackage
+		className := generator.TypeName(integration.Name) + "Client"
+		if service.Name != "" {
+			javaPackage = basePackage + "." + packageSegment(service.Name)
+			className = generator.TypeName(service.Name) + "Client"
+		}
+		modelPackage := javaPackage + ".model"
+		types := newTypeMapper(model.Decls(), formats)
+
+		// Write the records and enums
+		for _, decl := range model.Decls() {
+			if decl.Kind == generator.AliasDecl {
+				continue // Java has no type aliases, uses of the alias get the aliased type
+			}
+			if err := writeModel(srcDir, modelPackage, decl, types); err != nil {
+				return err
+			}
+		}
+
+		// Write the client class
+		clientPath := filepath.Join(packageDir(srcDir, javaPackage), className+".java")
+		if err := writeClient(tmpl, clientPath, integration.Name, service.Name, javaPackage, className, model, types); err != nil {
+			return err
+		}
+		count += len(model.Operations)
+
+		fmt.Printf("  - Generated: %s\n", clientPath)
+	}
+
+	if err := writePom(projectDir, integration, basePackage); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nSuccessfully generated %d Java methods\n", count)
+	return nil
+}
+
+// loadTemplate reads the client class template
+func loadTemplate() (*template.Template, error) {
+	tmplPath := "templates/java_client.tmpl"
+	tmplContent, err := os.ReadFile(tmplPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file %s: %v", tmplPath, err)
+	}
+
+	tmpl, err := template.New("java_client").Parse(string(tmplContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// writeClient renders the client class of a service
+func writeClient(
+	tmpl *template.Template,
+	filePath string,
+	integrationName string,
+	serviceName string,
+	javaPackage string,
+	className string,
+	model *generator.ServiceModel,
+	types *typeMapper,
+) error {
+	types.imports = make(map[string]bool)
+
+	var ops []Operation
+	methodNames := make(map[string]bool)
+	for _, op := range model.Operations {
+		name := memberName(op.Operation.Name)
+		for methodNames[name] {
+			name += "_"
+		}
+		methodNames[name] = true
+
+		view := Operation{
+			Name:       name,
+			FlowName:   op.Operation.Name,
+			Doc:        docLines(op.Operation.Description),
+			ReturnType: "void",
+		}
+
+		paramNames := make(map[string]bool)
+		for _, input := range op.Inputs {
+			paramName := memberName(input.Name)
+			for paramNames[paramName] {
+				paramName += "_"
+			}
+			paramNames[paramName] = true
+
+			view.Params = append(view.Params, Parameter{Name: paramName, Type: types.javaType(input.Schema)})
+
+			// Document the parameter, nulls are only allowed for optional ones
+			doc := []string{"@param", paramName}
+			if description := firstLine(input.Description); description != "" {
+				doc = append(doc, description)
+			}
+			if input.Required {
+				doc = append(doc, "(required)")
+			}
+			if len(doc) > 2 {
+				view.Doc = append(view.Doc, strings.Join(doc, " "))
+			}
+		}
+		if op.Output != nil {
+			view.ReturnType = types.javaType(op.Output.Schema)
+			if op.Output.Description != "" {
+				view.Doc = append(view.Doc, "@return "+firstLine(op.Output.Description))
+			}
+		}
+		if len(view.Doc) == 0 {
+			view.Doc = []string{"Runs the " + op.Operation.Name + " flow."}
+		}
+		ops = append(ops, view)
+	}
+
+	// Signatures refer to the model package and standard library types
+	imports := sortedKeys(types.imports)
+	for _, decl := range model.Decls() {
+		if decl.Kind != generator.AliasDecl {
+			imports = append(imports, javaPackage+".model.*")
+			break
+		}
+	}
+
+	service := serviceName
+	if service == "" {
+		service = integrationName
+	}
+	data := struct {
+		Integration string
+		Service     string
+		Package     string
+		Class       string
+		Imports     []string
+		Ops         []Operation
+	}{
+		Integration: integrationName,
+		Service:     service,
+		Package:     javaPackage,
+		Class:       className,
+		Imports:     imports,
+		Ops:         ops,
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return fmt.Errorf("failed to execute template: %v", err)
+	}
+	return writeFile(filePath, buffer.String())
+}
+
+// writeModel writes a record or enum for a declaration
+func writeModel(srcDir, modelPackage string, decl generator.Decl, types *typeMapper) error {
+	types.imports = make(map[string]bool)
+
+	var body string
+	switch decl.Kind {
+	case generator.StructDecl:
+		body = javaRecord(decl, types)
+	case generator.EnumDecl:
+		body = javaEnum(decl, types)
+	}
+
+	content := "// Generated by LowCodeFusion\n"
+	content += fmt.Sprintf("package %s;\n\n", modelPackage)
+	for _, imp := range sortedKeys(types.imports) {
+		content += fmt.Sprintf("import %s;\n", imp)
+	}
+	content += "\n" + javadoc(decl.Description, "From: "+decl.Source, "") + body
+
+	return writeFile(filepath.Join(packageDir(srcDir, modelPackage), decl.Name+".java"), content)
+}
+
+// javaRecord renders an immutable record; required components are checked for null
+func javaRecord(decl generator.Decl, types *typeMapper) string {
+	types.imports["com.fasterxml.jackson.annotation.JsonInclude"] = true
+	types.imports["com.fasterxml.jackson.annotation.JsonProperty"] = true
+
+	var components, checks []string
+	fieldNames := make(map[string]bool)
+	for _, propName := range decl.Schema.PropertyNames() {
+		prop := decl.Schema.Properties[propName]
+
+		fieldName := memberName(propName)
+		for fieldNames[fieldName] {
+			fieldName += "_"
+		}
+		fieldNames[fieldName] = true
+
+		component := ""
+		if doc := firstLine(prop.Description); doc != "" {
+			component += fmt.Sprintf("        /** %s */\n", escapeComment(doc))
+		}
+		component += fmt.Sprintf("        @JsonProperty(%s) %s %s", javaString(propName), types.javaType(prop), fieldName)
+		components = append(components, component)
+
+		if decl.Schema.IsRequired(propName) {
+			types.imports["java.util.Objects"] = true
+			checks = append(checks, fmt.Sprintf("        Objects.requireNonNull(%s, %s);", fieldName, javaString(propName+" is required")))
+		}
+	}
+
+	result := "@JsonInclude(JsonInclude.Include.NON_NULL)\n"
+	result += fmt.Sprintf("public record %s(\n%s\n) {\n", decl.Name, strings.Join(components, ",\n"))
+	if len(checks) > 0 {
+		result += fmt.Sprintf("    public %s {\n%s\n    }\n", decl.Name, strings.Join(checks, "\n"))
+	}
+	return result + "}\n"
+}
+
+// javaEnum renders an enum whose constants serialize to the schema values
+func javaEnum(decl generator.Decl, types *typeMapper) string {
+	types.imports["com.fasterxml.jackson.annotation.JsonValue"] = true
+
+	valueType, literal := "String", javaString
+	switch decl.Schema.Type {
+	case "integer":
+		valueType, literal = "long", func(v string) string { return v + "L" }
+	case "number":
+		valueType, literal = "double", func(v string) string { return v }
+	case "boolean":
+		valueType, literal = "boolean", func(v string) string { return v }
+	}
+
+	var constants []string
+	constNames := make(map[string]bool)
+	for i, val := range decl.Schema.Enum {
+		constName := constantName(val, i)
+		for constNames[constName] {
+			constName += "_"
+		}
+		constNames[constName] = true
+		constants = append(constants, fmt.Sprintf("    %s(%s)", constName, literal(val)))
+	}
+
+	result := fmt.Sprintf("public enum %s {\n", decl.Name)
+	result += strings.Join(constants, ",\n") + ";\n\n"
+	result += fmt.Sprintf("    private final %s value;\n\n", valueType)
+	result += fmt.Sprintf("    %s(%s value) {\n        this.value = value;\n    }\n\n", decl.Name, valueType)
+	result += fmt.Sprintf("    @JsonValue\n    public %s value() {\n        return value;\n    }\n", valueType)
+	return result + "}\n"
+}
+
+// typeMapper converts schemas to Java types and remembers the imports they need
+type typeMapper struct {
+	formats   generator.FormatTable
+	aliases   map[string]ir.Schema // alias declarations, replaced by the aliased type
+	expanding map[string]bool      // aliases being replaced, to stop at recursive ones
+	imports   map[string]bool
+}
+
+// newTypeMapper creates a typeMapper for the declarations of a service
+func newTypeMapper(decls []generator.Decl, formats generator.FormatTable) *typeMapper {
+	m := &typeMapper{
+		formats:   formats,
+		aliases:   make(map[string]ir.Schema),
+		expanding: make(map[string]bool),
+		imports:   make(map[string]bool),
+	}
+	for _, decl := range decls {
+		if decl.Kind == generator.AliasDecl {
+			m.aliases[decl.Name] = decl.Schema
+		}
+	}
+	return m
+}
+
+// javaType converts a flattened schema to a (boxed, nullable) Java type
+func (m *typeMapper) javaType(schema ir.Schema) string {
+	if name, ok := generator.DeclName(schema); ok {
+		if aliased, isAlias := m.aliases[name]; isAlias {
+			// An alias containing itself (e.g. a map of itself) cannot be spelled out
+			if m.expanding[name] {
+				return "Object"
+			}
+			m.expanding[name] = true
+			defer delete(m.expanding, name)
+			return m.javaType(aliased)
+		}
+		return name
+	}
+
+	if ft, ok := m.formats.Lookup(schema); ok {
+		if ft.Import != "" {
+			m.imports[ft.Import] = true
+		}
+		return ft.Type
+	}
+
+	switch schema.Type {
+	case "string":
+		return "String"
+	case "integer":
+		return "Long"
+	case "number":
+		return "Double"
+	case "boolean":
+		return "Boolean"
+	case "array":
+		m.imports["java.util.List"] = true
+		if schema.Items != nil {
+			return fmt.Sprintf("List<%s>", m.javaType(*schema.Items))
+		}
+		return "List<Object>"
+	case "object", "map":
+		m.imports["java.util.Map"] = true
+		if schema.AdditionalProperties != nil {
+			return fmt.Sprintf("Map<String, %s>", m.javaType(*schema.AdditionalProperties))
+		}
+		return "Map<String, Object>"
+	}
+
+	// oneOf and unknown types can hold anything
+	return "Object"
+}
+
+// memberName converts a name to a lowerCamelCase Java identifier (e.g. "RunInstances" -> "runInstances")
+func memberName(name string) string {
+	name = generator.TypeName(name)
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	name = string(runes)
+	if reservedWords[name] {
+		name += "_"
+	}
+	return name
+}
+
+// constantName converts an enum value to an UPPER_SNAKE_CASE constant (e.g. "t2.micro" -> "T2_MICRO")
+func constantName(val string, index int) string {
+	re := regexp.MustCompile(`[^A-Za-z0-9]+`)
+	name := strings.Trim(re.ReplaceAllString(val, "_"), "_")
+
+	// Split camelCase words (e.g. "inProgress" -> "IN_PROGRESS")
+	var sb strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			sb.WriteRune('_')
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+	name = sb.String()
+
+	if name == "" {
+		return fmt.Sprintf("VALUE_%d", index+1)
+	}
+	if unicode.IsDigit(runes[0]) {
+		return "VALUE_" + name
+	}
+	return name
+}
+
+// packageSegment converts a name to a Java package segment (e.g. "Active Directory" -> "activedirectory")
+func packageSegment(name string) string {
+	re := regexp.MustCompile(`[^a-z0-9_]`)
+	segment := re.ReplaceAllString(strings.ToLower(name), "")
+	if segment == "" || unicode.IsDigit(rune(segment[0])) {
+		segment = "_" + segment
+	}
+	if reservedWords[segment] {
+		segment += "_"
+	}
+	return segment
+}
+
+// packageDir returns the source directory of a Java package
+func packageDir(srcDir, javaPackage string) string {
+	return filepath.Join(srcDir, filepath.Join(strings.Split(javaPackage, ".")...))
+}
+
+// javaString quotes a string as a Java string literal
+func javaString(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s)
+	return `"` + s + `"`
+}
+
+// javadoc renders a Javadoc comment
+func javadoc(description, note, indent string) string {
+	lines := docLines(description)
+	if note != "" {
+		lines = append(lines, note)
+	}
+	result := indent + "/**\n"
+	for _, line := range lines {
+		result += strings.TrimRight(indent+" * "+line, " ") + "\n"
+	}
+	return result + indent + " */\n"
+}
+
+// docLines splits a description into comment lines that cannot end the comment
+func docLines(description string) []string {
+	description = strings.TrimSpace(escapeComment(description))
+	if description == "" {
+		return nil
+	}
+	return strings.Split(description, "\n")
+}
+
+// escapeComment keeps text from closing a comment
+func escapeComment(text string) string {
+	return strings.ReplaceAll(text, "*/", "*&#47;")
+}
+
+// firstLine returns the first line of a description
+func firstLine(description string) string {
+	return strings.TrimSpace(strings.SplitN(description, "\n", 2)[0])
+}
+
+// sortedKeys returns the keys of a set in order
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeFile writes a generated source file
+func writeFile(filePath, content string) error {
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", dir, err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write file %s: %v", filePath, err)
+	}
+	return nil
+}
+
+// writePom writes the Maven build of the project
+func writePom(projectDir string, integration *ir.Integration, basePackage string) error {
+	version := integration.Version
+	if version == "" {
+		version = "0.0.0-SNAPSHOT"
+	}
+
+	escape := func(s string) string {
+		var buf bytes.Buffer
+		_ = xml.EscapeText(&buf, []byte(s))
+		return buf.String()
+	}
+
+	pom := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!-- Generated by LowCodeFusion -->
+<project xmlns="http://maven.apache.org/POM/4.0.0"
+         xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+         xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 https://maven.apache.org/xsd/maven-4.0.0.xsd">
+  <modelVersion>4.0.0</modelVersion>
+
+  <groupId>%s</groupId>
+  <artifactId>%s</artifactId>
+  <version>%s</version>
+  <packaging>jar</packaging>
+  <name>%s</name>
+  <description>Generated SDK for the Pliant %s integration</description>
+
+  <properties>
+    <maven.compiler.release>17</maven.compiler.release>
+    <project.build.sourceEncoding>UTF-8</project.build.sourceEncoding>
+  </properties>
+
+  <dependencies>
+    <dependency>
+      <groupId>com.fasterxml.jackson.core</groupId>
+      <artifactId>jackson-annotations</artifactId>
+      <version>%s</version>
+    </dependency>
+  </dependencies>
+</project>
+`,
+		escape(basePackage),
+		escape(strings.ReplaceAll(packageSegment(integration.Name), "_", "-")+"-sdk"),
+		escape(version),
+		escape(integration.Name+" SDK"),
+		escape(integration.Name),
+		jacksonVersion,
+	)
+
+	pomPath := filepath.Join(projectDir, "pom.xml")
+	if err := os.WriteFile(pomPath, []byte(pom), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %v", pomPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// File: pkg/generator/java/java.go
+
+package java
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Generator is the Java target of lcf generate --lang java
+type Generator struct {
+	opts Options
+}
+
+func init() {
+	generator.Register(&Generator{opts: Options{Formats: DefaultFormats.Clone()}})
+}
+
+// Name selects the generator with --lang
+func (g *Generator) Name() string {
+	return "java"
+}
+
+// Description summarizes the generated SDK
+func (g *Generator) Description() string {
+	return "Maven project with records, enums and a client class per service"
+}
+
+// Capabilities lists the optional features of the Java SDK
+func (g *Generator) Capabilities() []generator.Capability {
+	return []generator.Capability{generator.TypedModels}
+}
+
+// Flags registers the Java-specific flags
+func (g *Generator) Flags(fs *pflag.FlagSet) {
+	fs.StringVarP(&g.opts.Package, "java-package", "", "", "Base package and Maven groupId of the Java SDK (default com.pliant.sdk.<integration>)")
+	fs.Var(g.opts.Formats, "java-format", "Java type of a JSON Schema format, as format=Type[@import] (repeatable)")
+}
+
+// Generate writes the Java SDK for an integration
+func (g *Generator) Generate(integration *ir.Integration, outDir string) error {
+	return Generate(integration, outDir, g.opts)
+}
@@ -0,0 +1,28 @@
+// Auto-generated Java stub for Pliant integration: Demo
+// Right now these methods only print their names.
+package com.pliant.sdk.demo;
+
+import java.util.List;
+
+/**
+ * Operations of the Demo service.
+ */
+public class DemoClient {
+
+    /**
+     * names
+     * @param inputs (required)
+     * @param count (required)
+     */
+    public String clash(String inputs, String result, String client, Long count, List<String> tags) {
+        System.out.println("Function name: Clash");
+        return null;
+    }
+
+    /**
+     * Checks that the integration is reachable
+     */
+    public void ping() {
+        System.out.println("Function name: Ping");
+    }
+}
@@ -0,0 +1,22 @@
+// Auto-generated Java stub for Pliant integration: Demo
+// Right now these methods only print their names.
+package com.pliant.sdk.demo.ec2;
+
+import java.time.OffsetDateTime;
+import com.pliant.sdk.demo.ec2.model.*;
+
+/**
+ * Operations of the ec2 service.
+ */
+public class Ec2Client {
+
+    /**
+     * Launches EC2 instances
+     * @param imageId ID of the AMI (required)
+     * @param maxCount Number of instances
+     */
+    public RunInstancesResult runInstances(String imageId, Long maxCount, RunInstancesInstanceType instanceType, OffsetDateTime startAt, Double price, RunInstancesTagSpecification tagSpecification) {
+        System.out.println("Function name: RunInstances");
+        return null;
+    }
+}
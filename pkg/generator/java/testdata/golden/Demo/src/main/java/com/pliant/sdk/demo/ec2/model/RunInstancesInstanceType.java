@@ -0,0 +1,23 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.ec2.model;
+
+import com.fasterxml.jackson.annotation.JsonValue;
+
+/**
+ * From: Demo/ec2/RunInstances.json
+ */
+public enum RunInstancesInstanceType {
+    T2_MICRO("t2.micro"),
+    T3_LARGE("t3.large");
+
+    private final String value;
+
+    RunInstancesInstanceType(String value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public String value() {
+        return value;
+    }
+}
@@ -0,0 +1,16 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.ec2.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+
+/**
+ * From: Demo/ec2/RunInstances.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record RunInstancesResult(
+        @JsonProperty("Instances") List<RunInstancesResultInstancesItem> instances,
+        @JsonProperty("ReservationId") String reservationId
+) {
+}
@@ -0,0 +1,16 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.ec2.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.time.OffsetDateTime;
+
+/**
+ * From: Demo/ec2/RunInstances.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record RunInstancesResultInstancesItem(
+        @JsonProperty("InstanceId") String instanceId,
+        @JsonProperty("LaunchTime") OffsetDateTime launchTime
+) {
+}
@@ -0,0 +1,20 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.ec2.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+import java.util.Objects;
+
+/**
+ * From: Demo/ec2/RunInstances.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record RunInstancesTagSpecification(
+        @JsonProperty("ResourceType") String resourceType,
+        @JsonProperty("Tags") List<Tag> tags
+) {
+    public RunInstancesTagSpecification {
+        Objects.requireNonNull(resourceType, "ResourceType is required");
+    }
+}
@@ -0,0 +1,15 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.ec2.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+/**
+ * From: Demo/ec2/RunInstances.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record Tag(
+        @JsonProperty("Key") String key,
+        @JsonProperty("Value") String value
+) {
+}
@@ -0,0 +1,20 @@
+// Auto-generated Java stub for Pliant integration: Demo
+// Right now these methods only print their names.
+package com.pliant.sdk.demo.net;
+
+import com.pliant.sdk.demo.net.model.*;
+
+/**
+ * Operations of the net service.
+ */
+public class NetClient {
+
+    /**
+     * Probes a host
+     * @param host (required)
+     */
+    public ProbeResult probe(ProbeHost host, Double threshold) {
+        System.out.println("Function name: Probe");
+        return null;
+    }
+}
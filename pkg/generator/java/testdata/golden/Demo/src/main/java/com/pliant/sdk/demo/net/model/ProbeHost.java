@@ -0,0 +1,39 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.net.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.net.InetAddress;
+import java.net.URI;
+import java.time.LocalDate;
+import java.time.OffsetDateTime;
+import java.time.OffsetTime;
+import java.util.Objects;
+import java.util.UUID;
+
+/**
+ * From: Demo/net/Probe.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record ProbeHost(
+        @JsonProperty("addr") InetAddress addr,
+        @JsonProperty("addr6") InetAddress addr6,
+        @JsonProperty("big") Long big,
+        @JsonProperty("bigstr") String bigstr,
+        @JsonProperty("blob") byte[] blob,
+        @JsonProperty("d") Double d,
+        @JsonProperty("day") LocalDate day,
+        @JsonProperty("f") Float f,
+        @JsonProperty("id") UUID id,
+        @JsonProperty("mail") String mail,
+        @JsonProperty("price") Double price,
+        @JsonProperty("ratio") Double ratio,
+        @JsonProperty("seen") OffsetDateTime seen,
+        @JsonProperty("site") URI site,
+        @JsonProperty("small") Integer small,
+        @JsonProperty("time") OffsetTime time
+) {
+    public ProbeHost {
+        Objects.requireNonNull(id, "id is required");
+    }
+}
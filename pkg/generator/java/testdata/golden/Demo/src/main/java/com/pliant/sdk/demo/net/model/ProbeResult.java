@@ -0,0 +1,22 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.net.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.net.InetAddress;
+import java.time.LocalDate;
+import java.time.OffsetTime;
+import java.util.UUID;
+
+/**
+ * From: Demo/net/Probe.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record ProbeResult(
+        @JsonProperty("addr") InetAddress addr,
+        @JsonProperty("day") LocalDate day,
+        @JsonProperty("id") UUID id,
+        @JsonProperty("latency") Double latency,
+        @JsonProperty("time") OffsetTime time
+) {
+}
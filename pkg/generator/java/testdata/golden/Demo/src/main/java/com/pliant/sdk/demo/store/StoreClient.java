@@ -0,0 +1,31 @@
+// Auto-generated Java stub for Pliant integration: Demo
+// Right now these methods only print their names.
+package com.pliant.sdk.demo.store;
+
+import java.util.Map;
+import com.pliant.sdk.demo.store.model.*;
+
+/**
+ * Operations of the store service.
+ */
+public class StoreClient {
+
+    /**
+     * Adds a pet
+     * @param pet (required)
+     */
+    public Object addPet(Object pet, Object tags) {
+        System.out.println("Function name: AddPet");
+        return null;
+    }
+
+    /**
+     * Stores settings
+     * @param labels (required)
+     * @param config (required)
+     */
+    public Map<String, PutResultValue> put(Map<String, String> labels, Map<String, Object> counts, PutConfig config, Map<String, Object> tree, PutNested nested, Map<String, Object> anything) {
+        System.out.println("Function name: Put");
+        return null;
+    }
+}
@@ -0,0 +1,19 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.Objects;
+
+/**
+ * From: Demo/store/AddPet.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record Base(
+        @JsonProperty("kind") String kind,
+        @JsonProperty("name") String name
+) {
+    public Base {
+        Objects.requireNonNull(name, "name is required");
+    }
+}
@@ -0,0 +1,22 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.Objects;
+
+/**
+ * A cat
+ * From: Demo/store/AddPet.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record Cat(
+        @JsonProperty("kind") CatKind kind,
+        @JsonProperty("lives") Long lives,
+        @JsonProperty("name") String name
+) {
+    public Cat {
+        Objects.requireNonNull(kind, "kind is required");
+        Objects.requireNonNull(name, "name is required");
+    }
+}
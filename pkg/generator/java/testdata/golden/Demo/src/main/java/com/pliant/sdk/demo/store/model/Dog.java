@@ -0,0 +1,20 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.Objects;
+
+/**
+ * From: Demo/store/AddPet.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record Dog(
+        @JsonProperty("bark") Object bark,
+        @JsonProperty("kind") DogKind kind,
+        @JsonProperty("name") String name
+) {
+    public Dog {
+        Objects.requireNonNull(name, "name is required");
+    }
+}
@@ -0,0 +1,15 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+/**
+ * From: Demo/store/AddPet.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record Kitten(
+        @JsonProperty("kind") KittenKind kind,
+        @JsonProperty("lives") Long lives
+) {
+}
@@ -0,0 +1,22 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonValue;
+
+/**
+ * From: Demo/store/AddPet.json
+ */
+public enum PuppyKind {
+    D("d");
+
+    private final String value;
+
+    PuppyKind(String value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public String value() {
+        return value;
+    }
+}
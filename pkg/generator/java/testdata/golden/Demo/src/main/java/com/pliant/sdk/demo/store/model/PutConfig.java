@@ -0,0 +1,18 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.Objects;
+
+/**
+ * From: Demo/store/Put.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record PutConfig(
+        @JsonProperty("name") String name
+) {
+    public PutConfig {
+        Objects.requireNonNull(name, "name is required");
+    }
+}
@@ -0,0 +1,16 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+import java.util.Map;
+
+/**
+ * From: Demo/store/Put.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record PutNested(
+        @JsonProperty("m") Map<String, List<String>> m
+) {
+}
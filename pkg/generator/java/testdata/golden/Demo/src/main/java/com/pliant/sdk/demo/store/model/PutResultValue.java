@@ -0,0 +1,14 @@
+// Generated by LowCodeFusion
+package com.pliant.sdk.demo.store.model;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+/**
+ * From: Demo/store/Put.json
+ */
+@JsonInclude(JsonInclude.Include.NON_NULL)
+public record PutResultValue(
+        @JsonProperty("v") Long v
+) {
+}
@@ -0,0 +1,307 @@
+// File: pkg/generator/model.go
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// declRefPrefix marks schema references that point at a Decl instead of a JSON schema definition
+const declRefPrefix = "#/decls/"
+
+// DeclKind tells what a declaration describes
+type DeclKind int
+
+const (
+	StructDecl DeclKind = iota // object with known properties
+	EnumDecl                   // closed list of values
+	AliasDecl                  // any other complex schema (array, map, union)
+)
+
+// Decl is a named type a statically typed target declares for a schema.
+// Nested objects and enums in Schema are replaced by references to their own Decls,
+// so a target only has to map scalars, arrays, maps and references.
+type Decl struct {
+	Name        string    // type name, unique within the service (e.g. "RunInstancesBody")
+	Kind        DeclKind  // what the declaration describes
+	Schema      ir.Schema // the declared schema
+	Description string    // description of the schema
+	Source      string    // flow the schema comes from (e.g. "AWS/ec2/RunInstances.json")
+}
+
+// ServiceModel holds the declarations of one service, split like the Python _types:
+// definitions used by several operations are common, everything else belongs to its operation.
+type ServiceModel struct {
+	Service    *ir.Service
+	Common     []Decl            // declarations shared by operations of the service
+	Operations []*OperationModel // in service order
+}
+
+// OperationModel holds an operation with parameters pointing at declarations
+type OperationModel struct {
+	Operation *ir.Operation
+	Inputs    []*ir.Parameter // complex schemas are replaced by references to Decls
+	Output    *ir.Parameter   // nil if the flow has no output
+	Decls     []Decl          // declarations used only by this operation
+}
+
+// NewServiceModel flattens the schemas of a service into named declarations
+func NewServiceModel(service *ir.Service) *ServiceModel {
+	b := &modelBuilder{
+		used:     make(map[string]bool),
+		defNames: make(map[string]string),
+	}
+	model := &ServiceModel{Service: service}
+
+	// Collect the definitions and the operations using them
+	defSchemas := make(map[string]ir.Schema)
+	defSources := make(map[string]string)
+	defUsers := make(map[string]map[*ir.Operation]bool)
+	for _, op := range service.Operations {
+		for _, param := range parameters(op) {
+			for _, defName := range param.Schema.DefinitionNames() {
+				if _, seen := defSchemas[defName]; !seen {
+					defSchemas[defName] = param.Schema.Definitions[defName]
+					defSources[defName] = op.FlowPath
+					defUsers[defName] = make(map[*ir.Operation]bool)
+				}
+				defUsers[defName][op] = true
+			}
+		}
+	}
+
+	// Name definitions first so references resolve regardless of order
+	defNames := make([]string, 0, len(defSchemas))
+	for defName := range defSchemas {
+		defNames = append(defNames, defName)
+	}
+	sort.Strings(defNames)
+	for _, defName := range defNames {
+		b.defNames[defName] = b.unique(TypeName(defName))
+	}
+
+	// Declare every definition, shared ones go to the common declarations
+	opDecls := make(map[*ir.Operation][]Decl)
+	for _, defName := range defNames {
+		b.decls = nil
+		b.declareAs(b.defNames[defName], defSchemas[defName], defSources[defName])
+		if len(defUsers[defName]) > 1 {
+			model.Common = append(model.Common, b.decls...)
+			continue
+		}
+		for op := range defUsers[defName] {
+			opDecls[op] = append(opDecls[op], b.decls...)
+		}
+	}
+
+	// Declare the parameters and results of each operation
+	for _, op := range service.Operations {
+		b.decls = opDecls[op]
+		opModel := &OperationModel{Operation: op}
+		for _, input := range op.Inputs {
+			param := *input
+			param.Schema = b.declare(TypeName(op.Name, input.Name), input.Schema, op.FlowPath, true)
+			opModel.Inputs = append(opModel.Inputs, &param)
+		}
+		if op.Output != nil {
+			param := *op.Output
+			param.Schema = b.declare(TypeName(op.Name, "Result"), op.Output.Schema, op.FlowPath, true)
+			opModel.Output = &param
+		}
+		opModel.Decls = b.decls
+		model.Operations = append(model.Operations, opModel)
+	}
+
+	return model
+}
+
+// Decls returns every declaration of the service, common ones first
+func (m *ServiceModel) Decls() []Decl {
+	decls := append([]Decl{}, m.Common...)
+	for _, op := range m.Operations {
+		decls = append(decls, op.Decls...)
+	}
+	return decls
+}
+
+// parameters returns the inputs and the output of an operation
+func parameters(op *ir.Operation) []*ir.Parameter {
+	params := append([]*ir.Parameter{}, op.Inputs...)
+	if op.Output != nil {
+		params = append(params, op.Output)
+	}
+	return params
+}
+
+// modelBuilder assigns names and collects declarations for one service
+type modelBuilder struct {
+	used     map[string]bool   // type names taken in the service
+	defNames map[string]string // definition name -> declaration name
+	decls    []Decl            // declarations collected for the current owner
+}
+
+// unique returns name, or name with a numeric suffix if it is taken
+func (b *modelBuilder) unique(name string) string {
+	candidate := name
+	for i := 2; b.used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	b.used[candidate] = true
+	return candidate
+}
+
+// declare flattens a schema and returns what to use where it appears.
+// Root schemas always get a declaration if they are complex, nested ones only if they are objects or enums.
+func (b *modelBuilder) declare(name string, s ir.Schema, source string, root bool) ir.Schema {
+	// References to definitions point at the definition's declaration
+	if strings.HasPrefix(s.Ref, "#/definitions/") {
+		if declName, ok := b.defNames[s.RefName()]; ok {
+			return declRef(declName, s)
+		}
+		// Unresolvable references fall back to the type copied from the definition, if any
+		s.Ref = ""
+	}
+
+	switch {
+	case len(s.Enum) > 0,
+		s.Type == "object" && len(s.Properties) > 0,
+		root && (s.IsComplex() || len(s.OneOf) > 0):
+		return declRef(b.declareAs(b.unique(name), s, source), s)
+	}
+
+	return b.flatten(name, s, source)
+}
+
+// declareAs adds the declaration of a schema under a name that is already reserved
+func (b *modelBuilder) declareAs(name string, s ir.Schema, source string) string {
+	decl := Decl{
+		Name:        name,
+		Kind:        AliasDecl,
+		Description: s.Description,
+		Source:      source,
+	}
+	switch {
+	case len(s.Enum) > 0:
+		decl.Kind = EnumDecl
+	case s.Type == "object" && len(s.Properties) > 0:
+		decl.Kind = StructDecl
+	}
+
+	// Reserve the slot before nested declarations so parents come first
+	index := len(b.decls)
+	b.decls = append(b.decls, decl)
+	b.decls[index].Schema = b.flatten(name, s, source)
+	return name
+}
+
+// flatten replaces the nested objects and enums of a schema by references
+func (b *modelBuilder) flatten(name string, s ir.Schema, source string) ir.Schema {
+	// Definitions that only refer to another definition alias its declaration
+	if declName, ok := b.defNames[s.RefName()]; ok && strings.HasPrefix(s.Ref, "#/definitions/") {
+		return declRef(declName, s)
+	}
+
+	flat := s
+	flat.Definitions = nil
+
+	if len(s.Properties) > 0 {
+		flat.Properties = make(map[string]ir.Schema, len(s.Properties))
+		for _, propName := range s.PropertyNames() {
+			flat.Properties[propName] = b.declare(TypeName(name, propName), s.Properties[propName], source, false)
+		}
+	}
+	if s.Items != nil {
+		items := b.declare(name+"Item", *s.Items, source, false)
+		flat.Items = &items
+	}
+	if s.AdditionalProperties != nil {
+		values := b.declare(name+"Value", *s.AdditionalProperties, source, false)
+		flat.AdditionalProperties = &values
+	}
+	if len(s.OneOf) > 0 {
+		flat.OneOf = make([]ir.Schema, 0, len(s.OneOf))
+		for i, variant := range s.OneOf {
+			flat.OneOf = append(flat.OneOf, b.declare(fmt.Sprintf("%sOption%d", name, i+1), variant, source, false))
+		}
+	}
+	return flat
+}
+
+// declRef returns a reference to a declaration that keeps the metadata of the referring schema
+func declRef(declName string, s ir.Schema) ir.Schema {
+	return ir.Schema{
+		Name:        s.Name,
+		Type:        s.Type,
+		Description: s.Description,
+		Ref:         declRefPrefix + declName,
+		Default:     s.Default,
+	}
+}
+
+// DeclName returns the declaration a schema refers to, if any
+func DeclName(s ir.Schema) (string, bool) {
+	if !strings.HasPrefix(s.Ref, declRefPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(s.Ref, declRefPrefix), true
+}
+
+// DeclRefs returns the declarations a schema refers to, sorted
+func DeclRefs(s ir.Schema) []string {
+	seen := make(map[string]bool)
+	collectDeclRefs(s, seen)
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// collectDeclRefs walks a flattened schema
+func collectDeclRefs(s ir.Schema, seen map[string]bool) {
+	if name, ok := DeclName(s); ok {
+		seen[name] = true
+	}
+	for _, prop := range s.Properties {
+		collectDeclRefs(prop, seen)
+	}
+	if s.Items != nil {
+		collectDeclRefs(*s.Items, seen)
+	}
+	if s.AdditionalProperties != nil {
+		collectDeclRefs(*s.AdditionalProperties, seen)
+	}
+	for _, variant := range s.OneOf {
+		collectDeclRefs(variant, seen)
+	}
+}
+
+// TypeName joins names into a PascalCase type name (e.g. "RunInstances", "body" -> "RunInstancesBody")
+func TypeName(parts ...string) string {
+	var sb strings.Builder
+	for _, part := range parts {
+		for _, word := range strings.FieldsFunc(part, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			sb.WriteString(string(runes))
+		}
+	}
+
+	name := sb.String()
+	if name == "" {
+		return "Type"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		return "T" + name
+	}
+	return name
+}
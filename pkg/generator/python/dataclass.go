@@ -0,0 +1,121 @@
+// File: pkg/generator/python/dataclass.go
+
+package python
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// dataclassAttributes are the methods and imported names a dataclass field must not shadow
+var dataclassAttributes = map[string]bool{
+	"to_dict": true, "from_dict": true, "dataclass": true, "field": true, "Mapping": true,
+}
+
+// dataclassImports returns the imports of a types module with dataclasses;
+// the conversion helpers live in _types/_dataclass.py
+func dataclassImports(filePath string) string {
+	helpers := ".._dataclass"
+	if filepath.Base(filepath.Dir(filePath)) == "_types" {
+		helpers = "._dataclass"
+	}
+	result := "from dataclasses import dataclass, field\n"
+	result += "from typing import Mapping\n"
+	result += fmt.Sprintf("from %s import from_dict as _from_dict, to_dict as _to_dict\n", helpers)
+	return result
+}
+
+// generateDataclass generates a dataclass with to_dict/from_dict helpers for an object schema.
+// Required fields come first as they have no default; properties that are not
+// Python identifiers keep their name in the field metadata. Keys that are not
+// listed in an object with additionalProperties go to an additional_properties dict.
+func generateDataclass(schema ir.Schema, rootTypes map[string]bool, formats generator.FormatTable) string {
+	result := fmt.Sprintf("@dataclass\nclass %s:\n", schema.Name)
+	if schema.Description != "" {
+		result += fmt.Sprintf("    \"\"\"%s\"\"\"\n\n", schema.Description)
+	}
+
+	var required, optional []string
+	reserved := withFormatTypes(dataclassAttributes, formats)
+	usedNames := make(map[string]bool)
+	for _, propName := range schema.PropertyNames() {
+		propType := schema.Properties[propName]
+		pythonType := schemaTypeToPythonType(propType, rootTypes, formats)
+
+		fieldName := pythonFieldName(propName, pythonType, reserved)
+		for usedNames[fieldName] {
+			fieldName += "_"
+		}
+		usedNames[fieldName] = true
+
+		isRequired := schema.IsRequired(propName)
+		if !isRequired {
+			pythonType = fmt.Sprintf("Optional[%s]", pythonType)
+		}
+
+		line := fmt.Sprintf("    %s: %s", fieldName, pythonType)
+		switch {
+		case fieldName != propName && isRequired:
+			line += fmt.Sprintf(" = field(metadata={\"name\": %s})", pythonString(propName))
+		case fieldName != propName:
+			line += fmt.Sprintf(" = field(default=None, metadata={\"name\": %s})", pythonString(propName))
+		case !isRequired:
+			line += " = None"
+		}
+		if propType.Description != "" {
+			line += "  # " + strings.ReplaceAll(propType.Description, "\n", " ")
+		}
+
+		if isRequired {
+			required = append(required, line)
+		} else {
+			optional = append(optional, line)
+		}
+	}
+
+	// Other keys are collected in a dict after the listed fields
+	if schema.AdditionalProperties != nil {
+		fieldName := "additional_properties"
+		for usedNames[fieldName] {
+			fieldName += "_"
+		}
+		valueType := schemaTypeToPythonType(*schema.AdditionalProperties, rootTypes, formats)
+		optional = append(optional, fmt.Sprintf(
+			"    %s: Dict[str, %s] = field(default_factory=dict, metadata={\"additional\": True})", fieldName, valueType))
+	}
+
+	fields := append(required, optional...)
+	if len(fields) > 0 {
+		result += strings.Join(fields, "\n") + "\n\n"
+	}
+
+	result += "    def to_dict(self) -> Dict[str, Any]:\n"
+	result += "        \"\"\"Returns the object as a dict keyed by property names.\"\"\"\n"
+	result += "        return _to_dict(self)\n\n"
+	result += "    @classmethod\n"
+	result += fmt.Sprintf("    def from_dict(cls, data: Mapping[str, Any]) -> %s:\n", schema.Name)
+	result += "        \"\"\"Creates the object from a dict keyed by property names.\"\"\"\n"
+	result += "        return _from_dict(cls, data)\n"
+
+	return result + "\n"
+}
+
+// writeDataclassHelpers writes the conversion helpers the generated dataclasses use
+func writeDataclassHelpers(typesDir string) error {
+	tmplPath := "templates/python_dataclass.tmpl"
+	content, err := os.ReadFile(tmplPath)
+	if err != nil {
+		return fmt.Errorf("failed to read template file %s: %v", tmplPath, err)
+	}
+
+	helpersPath := filepath.Join(typesDir, "_dataclass.py")
+	if err := os.WriteFile(helpersPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write file %s: %v", helpersPath, err)
+	}
+	return nil
+}
@@ -8,12 +8,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
 )
 
 // Operation represents a single integration operation
@@ -22,49 +26,27 @@ type Operation struct {
 	Parameters  []Parameter
 	ReturnType  string
 	Description string
-	ModulePath  string // Path to the module (e.g., "AWS.ec2")
-	FilePath    string // Path to the original JSON file
+	ModulePath  string     // Path to the module (e.g., "AWS.ec2")
+	FilePath    string     // Path to the original JSON file
+	Result      *ir.Schema // Schema of the return value, nil if the flow has no output
+	FlowPath    string     // Flow the function runs (e.g., "AWS/ec2/RunInstances")
+	Output      string     // Name of the output variable, empty if the flow has no output
+	Parent      string     // Relative import prefix of the integration package (e.g., ".." in AWS.ec2)
+	ClientParam string     // Name of the keyword argument that selects the client
+	TypesModule string     // Package of the operation's types under _types (e.g., "ec2")
+	ResultModel string     // Registered type of the return value, empty if none
 }
 
 // Parameter represents an input to an operation
 type Parameter struct {
 	Name        string
+	Key         string // Name of the flow variable the value is sent as
+	Model       string // Registered type the value is validated against, empty if none
+	Default     string // Python literal of the schema default, empty if none
 	Type        string
 	Required    bool
 	Description string
-}
-
-// FlowFile represents the JSON structure of a flow file
-type FlowFile struct {
-	Name      string    `json:"name"`
-	Processes []Process `json:"processes"`
-	Meta      FlowMeta  `json:"meta"`
-}
-
-// Process represents a process within a flow
-type Process struct {
-	Name      string     `json:"name"`
-	Variables []Variable `json:"variables"`
-}
-
-// Variable represents a variable within a process
-type Variable struct {
-	Name     string       `json:"name"`
-	IsInput  bool         `json:"isInput"`
-	IsOutput bool         `json:"isOutput"`
-	Required bool         `json:"required"`
-	Meta     VariableMeta `json:"meta"`
-	Type     interface{}  `json:"type"`
-}
-
-// VariableMeta contains metadata for a variable
-type VariableMeta struct {
-	Description string `json:"description"`
-}
-
-// FlowMeta contains metadata for a flow
-type FlowMeta struct {
-	Info string `json:"info"`
+	Schema      ir.Schema
 }
 
 // TypeDefinition represents a complex type definition
@@ -72,9 +54,10 @@ type TypeDefinition struct {
 	Name          string
 	PythonType    string
 	Description   string
-	FilePath      string // Path to the file that defines this type
-	ModulePath    string // Module path where this type is used (e.g., "AWS.ec2")
-	OperationName string // Name of the operation that uses this type (e.g., "RunInstances")
+	FilePath      string    // Path to the file that defines this type
+	ModulePath    string    // Module path where this type is used (e.g., "AWS.ec2")
+	OperationName string    // Name of the operation that uses this type (e.g., "RunInstances")
+	Schema        ir.Schema // Schema the type is generated from
 }
 
 // TypeFingerprint represents the structural essence of a type definition
@@ -114,6 +97,10 @@ type TypeRegistry struct {
 	OperationToService map[string]string
 	// Initial dir for the registry
 	Dir string
+	// Style of the generated classes (TypedDictModels, PydanticModels or DataclassModels)
+	Models string
+	// Python types of JSON Schema formats
+	Formats generator.FormatTable
 }
 
 // NewTypeRegistry creates a new TypeRegistry
@@ -127,6 +114,8 @@ func NewTypeRegistry(dir string) *TypeRegistry {
 		TypeDependencies:   make(map[string]map[string]bool),
 		OperationToService: make(map[string]string),
 		Dir:                dir,
+		Models:             TypedDictModels,
+		Formats:            DefaultFormats,
 	}
 }
 
@@ -138,6 +127,7 @@ func (tr *TypeRegistry) RegisterType(
 	filePath string,
 	modulePath string,
 	operationName string,
+	schema ir.Schema,
 ) TypeDefinition {
 	// Normalize the type name
 	normalizedName := sanitizeName(name)
@@ -155,25 +145,12 @@ func (tr *TypeRegistry) RegisterType(
 		FilePath:      filePath,
 		ModulePath:    modulePath,
 		OperationName: operationName,
+		Schema:        schema,
 	}
 
-	// Add to the registry
+	// Add to the registry; AnalyzeTypeUsage records the operations using it
 	tr.Types[normalizedName] = typeDef
 
-	// Get service name from module path
-	serviceName := modulePath
-	if parts := strings.Split(modulePath, "."); len(parts) > 1 {
-		serviceName = parts[1] // Get the service name (e.g., "ec2" from "AWS.ec2")
-	}
-
-	// Initialize type usage tracking if needed
-	if tr.TypeUsage[normalizedName] == nil {
-		tr.TypeUsage[normalizedName] = make(map[string]bool)
-	}
-
-	// Mark this type as used by this service
-	tr.TypeUsage[normalizedName][serviceName] = true
-
 	return typeDef
 }
 
@@ -188,10 +165,10 @@ func (tr *TypeRegistry) FingerprintType(typeDef TypeDefinition) (string, error)
 func (tr *TypeRegistry) AnalyzeTypeUsage() {
 	// Map to track number of common types per service
 	serviceCommonTypeCount := make(map[string]int)
-	
+
 	// First pass: identify which operations each type is used in and map operations to services
 	fmt.Println("\n=== Type Analysis - First Pass ===")
-	
+
 	for typeName, typeDef := range tr.Types {
 		// Extract service name from module path (second part only, not the integration name)
 		// For example, from "AWS.ec2" we want just "ec2"
@@ -214,7 +191,7 @@ func (tr *TypeRegistry) AnalyzeTypeUsage() {
 
 		// Store the mapping from operation to service
 		tr.OperationToService[typeDef.OperationName] = serviceName
-		
+
 		// Debug output
 		fmt.Printf("- Type %s used by operation %s in service %s\n", typeName, typeDef.OperationName, serviceName)
 
@@ -228,9 +205,9 @@ func (tr *TypeRegistry) AnalyzeTypeUsage() {
 			tr.OperationTypes[typeDef.OperationName] = make(map[string]TypeDefinition)
 		}
 	}
-	
+
 	fmt.Println("\n=== Type Analysis - Second Pass ===")
-	
+
 	// Second pass: determine if types should be in service common or operation-specific
 	for typeName, operations := range tr.TypeUsage {
 		typeDef := tr.Types[typeName]
@@ -258,18 +235,18 @@ func (tr *TypeRegistry) AnalyzeTypeUsage() {
 
 			// Add to service common types
 			tr.ServiceCommonTypes[serviceName][typeName] = typeDef
-			
+
 			// Increment common type count for this service
 			serviceCommonTypeCount[serviceName]++
-			
+
 			// List the operations this type is used in
 			opList := make([]string, 0, len(operations))
 			for op := range operations {
 				opList = append(opList, op)
 			}
 			sort.Strings(opList) // Sort for consistent output
-			
-			fmt.Printf("- Common type: %s in service %s (used by %d operations: %s)\n", 
+
+			fmt.Printf("- Common type: %s in service %s (used by %d operations: %s)\n",
 				typeName, serviceName, len(operations), strings.Join(opList, ", "))
 		} else {
 			// Type is specific to a single operation or used across multiple services
@@ -283,21 +260,21 @@ func (tr *TypeRegistry) AnalyzeTypeUsage() {
 
 				tr.OperationTypes[operationName][typeName] = typeDef
 			}
-			
+
 			// List the operations this type is used in
 			opList := make([]string, 0, len(operations))
 			for op := range operations {
 				opList = append(opList, op)
 			}
 			sort.Strings(opList) // Sort for consistent output
-			
+
 			if len(operations) == 1 {
 				singleOperation := ""
 				for op := range operations {
 					singleOperation = op
 					break
 				}
-				fmt.Printf("- Operation-specific type: %s (used only by %s)\n", 
+				fmt.Printf("- Operation-specific type: %s (used only by %s)\n",
 					typeName, singleOperation)
 			} else {
 				serviceList := make([]string, 0, len(serviceMap))
@@ -305,13 +282,13 @@ func (tr *TypeRegistry) AnalyzeTypeUsage() {
 					serviceList = append(serviceList, s)
 				}
 				sort.Strings(serviceList) // Sort for consistent output
-				
-				fmt.Printf("- Cross-service type: %s (used by %d operations across %d services: %s)\n", 
+
+				fmt.Printf("- Cross-service type: %s (used by %d operations across %d services: %s)\n",
 					typeName, len(operations), len(serviceMap), strings.Join(serviceList, ", "))
 			}
 		}
 	}
-	
+
 	// Print summary of common types per service
 	fmt.Println("\n=== Common Types Summary ===")
 	if len(serviceCommonTypeCount) == 0 {
@@ -323,13 +300,14 @@ func (tr *TypeRegistry) AnalyzeTypeUsage() {
 			services = append(services, service)
 		}
 		sort.Strings(services)
-		
+
 		for _, service := range services {
 			count := serviceCommonTypeCount[service]
 			fmt.Printf("Service %s: %d common types identified\n", service, count)
 		}
 	}
-	fmt.Println("===========================\n")
+	fmt.Println("===========================")
+	fmt.Println()
 }
 
 // DeduplicateTypes identifies and merges duplicate types
@@ -381,6 +359,11 @@ func (tr *TypeRegistry) WriteTypesFiles(outDir string) error {
 	if err := createInitFile(typesDir); err != nil {
 		return err
 	}
+	if tr.Models == DataclassModels {
+		if err := writeDataclassHelpers(typesDir); err != nil {
+			return err
+		}
+	}
 
 	// Generate service-specific common types and operation-specific types
 	fmt.Println("\n=== Generating Type Files ===")
@@ -404,9 +387,9 @@ func (tr *TypeRegistry) WriteTypesFiles(outDir string) error {
 			if err := tr.writeTypesFile(commonTypesPath, commonTypes); err != nil {
 				return fmt.Errorf("failed to write service common types file for %s: %w", serviceName, err)
 			}
-			fmt.Printf("- Generated service common types file: %s with %d common types\n", 
+			fmt.Printf("- Generated service common types file: %s with %d common types\n",
 				commonTypesPath, len(commonTypes))
-			
+
 			// List the common types
 			typeNames := make([]string, 0, len(commonTypes))
 			for typeName := range commonTypes {
@@ -417,7 +400,7 @@ func (tr *TypeRegistry) WriteTypesFiles(outDir string) error {
 		} else {
 			// Create an empty common_types.py file to prevent import errors
 			emptyContent := "# Generated by LowCodeFusion\n# Empty common types file\n"
-			
+
 			if err := os.WriteFile(commonTypesPath, []byte(emptyContent), 0644); err != nil {
 				return fmt.Errorf("failed to write empty common types file for %s: %w", serviceName, err)
 			}
@@ -451,15 +434,14 @@ func (tr *TypeRegistry) WriteTypesFiles(outDir string) error {
 			return fmt.Errorf("failed to write operation types file for %s: %w", operationName, err)
 		}
 
-		fmt.Printf("- Generated operation types file: %s with %d types\n", 
+		fmt.Printf("- Generated operation types file: %s with %d types\n",
 			operationTypesPath, len(operationTypes))
-		
+
 		// List the operation-specific types that aren't already in common types
 		typeNames := make([]string, 0, len(operationTypes))
 		for typeName := range operationTypes {
 			// Skip types that are already in the service's common types
-			if tr.ServiceCommonTypes[serviceName] != nil && 
-			   tr.ServiceCommonTypes[serviceName][typeName] != (TypeDefinition{}) {
+			if tr.isCommonType(serviceName, typeName) {
 				continue
 			}
 			typeNames = append(typeNames, typeName)
@@ -469,18 +451,31 @@ func (tr *TypeRegistry) WriteTypesFiles(outDir string) error {
 			fmt.Printf("  Operation-specific types: %s\n", strings.Join(typeNames, ", "))
 		}
 	}
-	
+
 	fmt.Println("===========================")
 
 	return nil
 }
 
+// isCommonType reports whether a type is shared within a service
+func (tr *TypeRegistry) isCommonType(serviceName, typeName string) bool {
+	_, ok := tr.ServiceCommonTypes[serviceName][typeName]
+	return ok
+}
+
 // writeTypesFile writes a collection of type definitions to a file
 func (tr *TypeRegistry) writeTypesFile(filePath string, types map[string]TypeDefinition) error {
 	// Generate file content
 	content := "# Generated by LowCodeFusion\n"
+	content += "from __future__ import annotations  # types may refer to types defined later\n\n"
 	content += "from typing import Any, Dict, List, Optional, Union, TypedDict, Literal\n"
-	content += "from datetime import datetime\n"
+	content += formatImports(tr.Formats)
+	switch tr.Models {
+	case PydanticModels:
+		content += pydanticImports
+	case DataclassModels:
+		content += dataclassImports(filePath)
+	}
 
 	// Add appropriate imports based on file type
 	if strings.Contains(filepath.Base(filePath), "common_types.py") {
@@ -496,6 +491,8 @@ func (tr *TypeRegistry) writeTypesFile(filePath string, types map[string]TypeDef
 
 	// Extract JSON schemas and generate rich type definitions
 	generatedTypes := make(map[string]bool)
+	aliases := ""
+	defAliases := "" // definition aliases, which the other aliases may refer to
 
 	// Process types in a deterministic order for consistency
 	typeNames := make([]string, 0, len(types))
@@ -512,344 +509,94 @@ func (tr *TypeRegistry) writeTypesFile(filePath string, types map[string]TypeDef
 		// Skip if this type is already in the service's common types
 		serviceName := tr.OperationToService[typeDef.OperationName]
 		if !strings.Contains(filePath, "common_types.py") &&
-			tr.ServiceCommonTypes[serviceName] != nil &&
-			tr.ServiceCommonTypes[serviceName][typeName] != (TypeDefinition{}) {
+			tr.isCommonType(serviceName, typeName) {
 			continue
 		}
 
-		// We need to parse the original JSON file to extract detailed schema information
-		fileContent, err := os.ReadFile(typeDef.FilePath)
-		if err != nil {
-			fmt.Printf("Warning: Could not read file %s: %v\n", typeDef.FilePath, err)
-			continue
-		}
+		// The type is named after the parameter or return value it describes
+		schema := typeDef.Schema
+		schema.Name = typeDef.Name
+		schema.IsRoot = true
 
-		var flowFile FlowFile
-		if err := json.Unmarshal(fileContent, &flowFile); err != nil {
-			fmt.Printf("Warning: Could not parse JSON from %s: %v\n", typeDef.FilePath, err)
-			continue
-		}
+		// Generate TypedDict classes for all complex types
+		if schema.Type == "object" && len(schema.Properties) > 0 {
+			// Generate TypedDict for the root object
+			typeDictCode := tr.generateClass(schema, generatedTypes)
+			content += fmt.Sprintf("# %s\n", typeDef.Description)
+			content += fmt.Sprintf("# From: %s\n", typeDef.FilePath)
+			content += typeDictCode
 
-		// Process only the first process (should be the main one)
-		if len(flowFile.Processes) == 0 {
-			continue
+			// Mark as generated
+			generatedTypes[schema.Name] = true
+		} else {
+			// Other types are aliases, written after all classes they may refer to
+			aliases += fmt.Sprintf("# %s\n", typeDef.Description)
+			aliases += fmt.Sprintf("# From: %s\n", typeDef.FilePath)
+			aliases += fmt.Sprintf("%s = %s\n\n", typeDef.Name, schemaTypeToPythonType(schema, generatedTypes, tr.Formats))
 		}
-		process := flowFile.Processes[0]
 
-		// Find the variable that matches this type
-		for _, variable := range process.Variables {
-			// Skip variables without types
-			if variable.Type == nil {
+		// Generate TypedDict classes for all nested definitions
+		for _, defName := range schema.DefinitionNames() {
+			defSchema := schema.Definitions[defName]
+			if generatedTypes[defName] {
 				continue
 			}
-
-			// See if this is a parameter or return type that we're looking for
-			isMatch := false
-			if strings.HasSuffix(typeDef.Name, "_Result_Type") && variable.IsOutput {
-				isMatch = true
-			} else if strings.Contains(typeDef.Name, "_"+variable.Name+"_Type") && variable.IsInput {
-				isMatch = true
-			}
-
-			if !isMatch {
-				continue
-			}
-
-			// Process the type
-			typeObj, ok := variable.Type.(map[string]interface{})
-			if !ok {
-				continue
-			}
-
-			// Extract definitions if they exist
-			definitions := make(map[string]interface{})
-			if defs, ok := typeObj["definitions"].(map[string]interface{}); ok {
-				definitions = defs
-			}
-
-			// Parse the schema
-			schema := jsonTypeToSchemaType(typeDef.Name, typeObj, definitions)
-			schema.IsRoot = true
-
-			// Generate TypedDict classes for all complex types
-			if schema.Type == "object" && len(schema.Properties) > 0 {
-				// Generate TypedDict for the root object
-				typeDictCode := generatePythonTypedDict(schema, generatedTypes)
-				content += fmt.Sprintf("# %s\n", typeDef.Description)
-				content += fmt.Sprintf("# From: %s\n", typeDef.FilePath)
+			if defSchema.Type == "object" && len(defSchema.Properties) > 0 {
+				typeDictCode := tr.generateClass(defSchema, generatedTypes)
 				content += typeDictCode
-
-				// Mark as generated
-				generatedTypes[schema.Name] = true
-
-				// Generate TypedDict classes for all nested definitions
-				if schema.Definitions != nil {
-					for defName, defSchema := range schema.Definitions {
-						if defSchema.Type == "object" && len(defSchema.Properties) > 0 && !generatedTypes[defName] {
-							typeDictCode := generatePythonTypedDict(defSchema, generatedTypes)
-							content += typeDictCode
-							generatedTypes[defName] = true
-						}
-					}
-				}
 			} else {
-				// For non-object types, use the simplified representation
-				content += fmt.Sprintf("# %s\n", typeDef.Description)
-				content += fmt.Sprintf("# From: %s\n", typeDef.FilePath)
-				content += fmt.Sprintf("%s = %s\n\n", typeDef.Name, typeDef.PythonType)
+				// Aliases are evaluated at import, so one containing itself is cut off at Any
+				aliased := schemaTypeToPythonType(defSchema, generatedTypes, tr.Formats)
+				selfRef := regexp.MustCompile(`\b` + regexp.QuoteMeta(defName) + `\b`)
+				defAliases += fmt.Sprintf("%s = %s\n\n", defName, selfRef.ReplaceAllString(aliased, "Any"))
 			}
+			generatedTypes[defName] = true
 		}
 	}
+	content += defAliases + aliases
 
 	// Write to file
 	return os.WriteFile(filePath, []byte(content), 0644)
 }
 
-// sanitizeName converts a name to a valid Python identifier
-func sanitizeName(name string) string {
-	// Replace spaces and other non-alphanumeric characters with underscores
-	re := regexp.MustCompile(`[^a-zA-Z0-9_]`)
-	return re.ReplaceAllString(name, "_")
-}
-
-// SchemaType represents a type extracted from a JSON schema
-type SchemaType struct {
-	Name        string                // Name of the type
-	Type        string                // Type (string, integer, object, array, etc.)
-	Format      string                // Format (date-time, etc.)
-	Description string                // Description of the type
-	Properties  map[string]SchemaType // Object properties
-	Items       *SchemaType           // Array item type
-	Enum        []string              // Enum values
-	Ref         string                // Reference to another type
-	Required    []string              // Required properties
-	OneOf       []SchemaType          // OneOf variants
-	IsRoot      bool                  // Is this a root type (not a nested type)
-	Definitions map[string]SchemaType // Type definitions (for root types)
-}
-
-// pathTracker is used to track the JSON schema reference path to detect circular references
-type pathTracker struct {
-	paths map[string]bool
-}
-
-// newPathTracker creates a new pathTracker
-func newPathTracker() *pathTracker {
-	return &pathTracker{
-		paths: make(map[string]bool),
+// generateClass generates the class of an object schema in the registry's model style
+func (tr *TypeRegistry) generateClass(schema ir.Schema, rootTypes map[string]bool) string {
+	switch tr.Models {
+	case PydanticModels:
+		return generatePydanticModel(schema, rootTypes, tr.Formats)
+	case DataclassModels:
+		return generateDataclass(schema, rootTypes, tr.Formats)
 	}
+	return generatePythonTypedDict(schema, rootTypes, tr.Formats)
 }
 
-// has checks if a path has been visited
-func (p *pathTracker) has(path string) bool {
-	return p.paths[path]
-}
-
-// add marks a path as visited
-func (p *pathTracker) add(path string) {
-	p.paths[path] = true
-}
-
-// remove marks a path as no longer visited
-func (p *pathTracker) remove(path string) {
-	delete(p.paths, path)
+// serviceOf returns the service of a module path, the integration for root operations
+// (e.g., "AWS.ec2.sub" -> "ec2", "AWS" -> "AWS")
+func serviceOf(modulePath string) string {
+	parts := strings.Split(modulePath, ".")
+	if len(parts) > 1 {
+		return parts[1]
+	}
+	return parts[0]
 }
 
-// jsonTypeToSchemaType converts a JSON schema object to a SchemaType
-func jsonTypeToSchemaType(typeName string, typeInfo interface{}, definitions map[string]interface{}) SchemaType {
-	return jsonTypeToSchemaTypeWithTracker(typeName, typeInfo, definitions, newPathTracker())
+// sanitizeName converts a name to a valid Python identifier
+func sanitizeName(name string) string {
+	// Replace spaces and other non-alphanumeric characters with underscores
+	re := regexp.MustCompile(`[^a-zA-Z0-9_]`)
+	return re.ReplaceAllString(name, "_")
 }
 
-// jsonTypeToSchemaTypeWithTracker converts a JSON schema object to a SchemaType with path tracking to avoid circular references
-func jsonTypeToSchemaTypeWithTracker(
-	typeName string,
-	typeInfo interface{},
-	definitions map[string]interface{},
-	tracker *pathTracker,
-) SchemaType {
-	schemaType := SchemaType{
-		Name:       typeName,
-		Properties: make(map[string]SchemaType),
-	}
-
-	// Handle simple string type
-	if typeStr, ok := typeInfo.(string); ok {
-		schemaType.Type = typeStr
-		return schemaType
-	}
-
-	// Handle complex type (object with properties)
-	if typeObj, ok := typeInfo.(map[string]interface{}); ok {
-		// Get direct type property
-		if typeType, ok := typeObj["type"].(string); ok {
-			schemaType.Type = typeType
-		}
-
-		// Get format if available
-		if format, ok := typeObj["format"].(string); ok {
-			schemaType.Format = format
-		}
-
-		// Get description if available
-		if desc, ok := typeObj["description"].(string); ok {
-			schemaType.Description = desc
-		}
-
-		// Get required properties
-		if req, ok := typeObj["required"].([]interface{}); ok {
-			for _, r := range req {
-				if reqStr, ok := r.(string); ok {
-					schemaType.Required = append(schemaType.Required, reqStr)
-				}
-			}
-		}
-
-		// Handle array type
-		if schemaType.Type == "array" {
-			if items, ok := typeObj["items"].(map[string]interface{}); ok {
-				// Check for circular reference
-				itemPath := typeName + ".items"
-				if !tracker.has(itemPath) {
-					tracker.add(itemPath)
-					itemType := jsonTypeToSchemaTypeWithTracker(typeName+"Item", items, definitions, tracker)
-					schemaType.Items = &itemType
-					tracker.remove(itemPath)
-				} else {
-					// Circular reference detected, use Any for items
-					schemaType.Items = &SchemaType{Name: "Any", Type: "any"}
-				}
-			}
-		}
-
-		// Handle object type with properties
-		if props, ok := typeObj["properties"].(map[string]interface{}); ok &&
-			(schemaType.Type == "object" || schemaType.Type == "") {
-			schemaType.Type = "object"
-
-			// Process a limited number of properties to avoid stack overflow
-			propCount := 0
-			for propName, propType := range props {
-				// Only process a reasonable number of properties (this is a safety measure)
-				if propCount >= 100 {
-					break
-				}
-
-				// Check for circular reference
-				propPath := typeName + ".properties." + propName
-				if !tracker.has(propPath) {
-					tracker.add(propPath)
-					schemaType.Properties[propName] = jsonTypeToSchemaTypeWithTracker(
-						propName,
-						propType,
-						definitions,
-						tracker,
-					)
-					tracker.remove(propPath)
-				} else {
-					// Circular reference detected, use Any for this property
-					schemaType.Properties[propName] = SchemaType{Name: propName, Type: "any"}
-				}
-				propCount++
-			}
-		}
-
-		// Handle enum values
-		if enumValues, ok := typeObj["enum"].([]interface{}); ok {
-			for _, val := range enumValues {
-				if strVal, ok := val.(string); ok {
-					schemaType.Enum = append(schemaType.Enum, strVal)
-				} else if numVal, ok := val.(float64); ok {
-					schemaType.Enum = append(schemaType.Enum, fmt.Sprintf("%v", numVal))
-				} else if boolVal, ok := val.(bool); ok {
-					schemaType.Enum = append(schemaType.Enum, fmt.Sprintf("%v", boolVal))
-				}
-			}
-		}
-
-		// Handle schema reference
-		if ref, ok := typeObj["$ref"].(string); ok {
-			schemaType.Ref = ref
-			// Extract the referenced type name
-			parts := strings.Split(ref, "/")
-			if len(parts) > 0 {
-				refTypeName := parts[len(parts)-1]
-
-				// Check for circular reference
-				refPath := fmt.Sprintf("$ref:%s", ref)
-				if !tracker.has(refPath) && len(parts) > 1 && parts[1] == "definitions" && definitions != nil {
-					// If it's a reference to a definition, try to resolve it
-					if defType, ok := definitions[refTypeName]; ok {
-						tracker.add(refPath)
-						// Get the basic type information from the reference, but don't resolve nested references
-						refSchema := jsonTypeToSchemaTypeWithTracker(refTypeName, defType, definitions, tracker)
-						tracker.remove(refPath)
-
-						// Just copy the essential info without deep nesting
-						schemaType.Type = refSchema.Type
-						schemaType.Format = refSchema.Format
-						// Don't copy properties deeply to avoid circular refs
-					}
-				}
-				// We'll just keep the reference name for later use
-			}
-		}
-
-		// Handle oneOf - limit depth to avoid recursion
-		if oneOfList, ok := typeObj["oneOf"].([]interface{}); ok && len(oneOfList) < 10 {
-			oneOfPath := typeName + ".oneOf"
-			if !tracker.has(oneOfPath) {
-				tracker.add(oneOfPath)
-				for i, oneOfType := range oneOfList {
-					// Limit to 5 oneOf variants to avoid explosion
-					if i >= 5 {
-						break
-					}
-					oneOfSchema := jsonTypeToSchemaTypeWithTracker(typeName+"OneOf", oneOfType, definitions, tracker)
-					schemaType.OneOf = append(schemaType.OneOf, oneOfSchema)
-				}
-				tracker.remove(oneOfPath)
-			}
-		}
-
-		// Handle definitions (only for root types) - with limits
-		if defs, ok := typeObj["definitions"].(map[string]interface{}); ok {
-			schemaType.Definitions = make(map[string]SchemaType)
-			defCount := 0
-
-			for defName, defType := range defs {
-				// Only process a reasonable number of definitions
-				if defCount >= 50 {
-					break
-				}
-
-				// Check for circular reference
-				defPath := "definitions." + defName
-				if !tracker.has(defPath) {
-					tracker.add(defPath)
-					schemaType.Definitions[defName] = jsonTypeToSchemaTypeWithTracker(defName, defType, defs, tracker)
-					tracker.remove(defPath)
-				} else {
-					// Just create a placeholder for circular references
-					schemaType.Definitions[defName] = SchemaType{Name: defName, Type: "any"}
-				}
-				defCount++
-			}
-		}
+// schemaTypeToPythonType converts a schema to a Python type string
+func schemaTypeToPythonType(schema ir.Schema, rootTypes map[string]bool, formats generator.FormatTable) string {
+	// Handle references first - they override the type
+	if refTypeName := schema.RefName(); refTypeName != "" {
+		return sanitizeName(refTypeName)
 	}
 
-	return schemaType
-}
-
-// schemaTypeToPythonType converts a SchemaType to a Python type string
-func schemaTypeToPythonType(schema SchemaType, rootTypes map[string]bool) string {
-	// Handle references first - they override the type
-	if schema.Ref != "" {
-		// Extract the referenced type name
-		parts := strings.Split(schema.Ref, "/")
-		if len(parts) > 0 {
-			refTypeName := parts[len(parts)-1]
-			return sanitizeName(refTypeName)
-		}
+	// Formats with a Python type, unless the values are listed
+	if ft, ok := formats.Lookup(schema); ok && len(schema.Enum) == 0 {
+		return ft.Type
 	}
 
 	// Handle different types
@@ -864,21 +611,29 @@ func schemaTypeToPythonType(schema SchemaType, rootTypes map[string]bool) string
 			}
 			return fmt.Sprintf("Literal[%s]", strings.Join(enumValues, ", "))
 		}
-		if schema.Format == "date-time" {
-			return "datetime"
-		}
 		return "str"
 	case "integer", "number":
+		// Numeric enum values are literals as well
+		if len(schema.Enum) > 0 {
+			return fmt.Sprintf("Literal[%s]", strings.Join(schema.Enum, ", "))
+		}
+		if schema.Type == "number" {
+			return "float"
+		}
 		return "int"
 	case "boolean":
 		return "bool"
 	case "array":
 		if schema.Items != nil {
-			itemType := schemaTypeToPythonType(*schema.Items, rootTypes)
+			itemType := schemaTypeToPythonType(*schema.Items, rootTypes, formats)
 			return fmt.Sprintf("List[%s]", itemType)
 		}
 		return "List[Any]"
-	case "object":
+	case "object", "map":
+		// Maps type their values
+		if schema.AdditionalProperties != nil && len(schema.Properties) == 0 {
+			return fmt.Sprintf("Dict[str, %s]", schemaTypeToPythonType(*schema.AdditionalProperties, rootTypes, formats))
+		}
 		// If this is a root type, it should have a registered TypedDict
 		if rootTypes[schema.Name] {
 			return schema.Name
@@ -889,7 +644,7 @@ func schemaTypeToPythonType(schema SchemaType, rootTypes map[string]bool) string
 		if len(schema.OneOf) > 0 {
 			types := make([]string, 0, len(schema.OneOf))
 			for _, oneOfType := range schema.OneOf {
-				types = append(types, schemaTypeToPythonType(oneOfType, rootTypes))
+				types = append(types, schemaTypeToPythonType(oneOfType, rootTypes, formats))
 			}
 			return fmt.Sprintf("Union[%s]", strings.Join(types, ", "))
 		}
@@ -897,8 +652,8 @@ func schemaTypeToPythonType(schema SchemaType, rootTypes map[string]bool) string
 	}
 }
 
-// generatePythonTypedDict generates Python TypedDict code for a SchemaType
-func generatePythonTypedDict(schema SchemaType, rootTypes map[string]bool) string {
+// generatePythonTypedDict generates Python TypedDict code for a schema
+func generatePythonTypedDict(schema ir.Schema, rootTypes map[string]bool, formats generator.FormatTable) string {
 	result := ""
 
 	// Generate docstring if description exists
@@ -909,22 +664,19 @@ func generatePythonTypedDict(schema SchemaType, rootTypes map[string]bool) strin
 		result += fmt.Sprintf("class %s(TypedDict, total=False):\n", schema.Name)
 	}
 
+	// A TypedDict cannot declare the type of other keys, so it is noted for readers
+	if schema.AdditionalProperties != nil && len(schema.Properties) > 0 {
+		result += fmt.Sprintf("    # Other keys: %s\n", schemaTypeToPythonType(*schema.AdditionalProperties, rootTypes, formats))
+	}
+
 	// Add properties
 	if len(schema.Properties) > 0 {
-		for propName, propType := range schema.Properties {
-			pythonType := schemaTypeToPythonType(propType, rootTypes)
-
-			// Check if property is required
-			isRequired := false
-			for _, req := range schema.Required {
-				if req == propName {
-					isRequired = true
-					break
-				}
-			}
+		for _, propName := range schema.PropertyNames() {
+			propType := schema.Properties[propName]
+			pythonType := schemaTypeToPythonType(propType, rootTypes, formats)
 
 			// Add Optional wrapper if not required
-			if !isRequired {
+			if !schema.IsRequired(propName) {
 				pythonType = fmt.Sprintf("Optional[%s]", pythonType)
 			}
 
@@ -945,187 +697,145 @@ func generatePythonTypedDict(schema SchemaType, rootTypes map[string]bool) strin
 	return result + "\n"
 }
 
-// jsonTypeToGoPythonType converts a JSON schema type to a Python type
-func jsonTypeToGoPythonType(typeInfo interface{}) string {
-	// This is now a simplified version that returns basic types
-	// The detailed type generation is handled by SchemaType and generatePythonTypedDict
-
-	// Handle simple string type
-	if typeStr, ok := typeInfo.(string); ok {
-		switch typeStr {
-		case "string":
-			return "str"
-		case "integer", "number":
-			return "int"
-		case "boolean":
-			return "bool"
-		case "array":
-			return "List[Any]"
-		case "object", "map":
-			return "Dict[str, Any]"
-		default:
-			return "Any"
-		}
+// newOperation converts an IR operation into the form the stub template renders
+func newOperation(integrationName string, op *ir.Operation, formats generator.FormatTable) Operation {
+	// Convert the flow location to a module path
+	// e.g., AWS + ["ec2"] -> "AWS.ec2"
+	// The prefix is used for import organization but doesn't affect the directory structure
+	modulePath := strings.ReplaceAll(integrationName, " ", "_")
+	if len(op.Path) > 0 {
+		dirPath := strings.ReplaceAll(strings.Join(op.Path, "."), " ", "_")
+		modulePath = fmt.Sprintf("%s.%s", modulePath, dirPath)
 	}
 
-	// Handle complex type (object with properties)
-	if typeObj, ok := typeInfo.(map[string]interface{}); ok {
-		// Check for direct type property
-		if typeType, ok := typeObj["type"].(string); ok {
-			switch typeType {
-			case "string":
-				return "str"
-			case "integer", "number":
-				return "int"
-			case "boolean":
-				return "bool"
-			case "array":
-				return "List[Any]"
-			case "object":
-				return "Dict[str, Any]"
-			}
-		}
-
-		// Check for schema reference
-		if ref, ok := typeObj["$ref"].(string); ok {
-			// Reference to another schema definition
-			parts := strings.Split(ref, "/")
-			if len(parts) > 0 {
-				typeName := parts[len(parts)-1]
-				return sanitizeName(typeName)
-			}
-		}
-
-		// If we have a oneOf, use Any for now
-		if _, ok := typeObj["oneOf"].([]interface{}); ok {
-			return "Any"
-		}
+	pyOp := Operation{
+		Name:        sanitizeName(op.Name),
+		Parameters:  []Parameter{},
+		ReturnType:  "None", // Default return type
+		Description: op.Description,
+		ModulePath:  modulePath,
+		FilePath:    op.FilePath,
+		FlowPath:    strings.TrimSuffix(op.FlowPath, ".json"),
+		TypesModule: serviceOf(modulePath),
+		Parent:      strings.Repeat(".", len(op.Path)+1),
+		ClientParam: "client",
 	}
 
-	// Default to Any for complex or unknown types
-	return "Any"
-}
-
-// parseOperations scans the directory structure and returns operations
-func parseOperations(srcDir string, integrationName string) ([]Operation, error) {
-	var operations []Operation
-
-	// Find the flows directory
-	flowsDir := filepath.Join(srcDir, "flows")
-	if _, err := os.Stat(flowsDir); os.IsNotExist(err) {
-		return nil, fmt.Errorf("flows directory not found in %s", srcDir)
+	// Inputs keep their flow names unless Python or the function body already uses them
+	used := make(map[string]bool, len(op.Inputs))
+	for _, input := range op.Inputs {
+		name := sanitizeName(input.Name)
+		for pythonKeywords[name] || templateNames[name] || used[name] {
+			name += "_"
+		}
+		used[name] = true
+		param := Parameter{
+			Name:        name,
+			Key:         input.Name,
+			Type:        schemaTypeToPythonType(input.Schema, nil, formats),
+			Required:    input.Required,
+			Description: input.Description,
+			Schema:      input.Schema,
+		}
+		// Required inputs are always passed, so only optional ones take the schema default
+		if input.Schema.Default != nil && !input.Required {
+			param.Default = pythonLiteral(input.Schema.Default)
+		}
+		pyOp.Parameters = append(pyOp.Parameters, param)
 	}
 
-	// Find the integration directory (e.g., AWS)
-	integrationDir := filepath.Join(flowsDir, integrationName)
-	if _, err := os.Stat(integrationDir); os.IsNotExist(err) {
-		return nil, fmt.Errorf("integration directory %s not found in flows", integrationName)
+	// An input named like the client parameter moves the client instead
+	for used[pyOp.ClientParam] {
+		pyOp.ClientParam = "pliant_" + pyOp.ClientParam
 	}
 
-	// Walk through the directory structure
-	err := filepath.Walk(integrationDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		// Skip directories
-		if info.IsDir() {
-			return nil
-		}
-
-		// Only process JSON files
-		if !strings.HasSuffix(strings.ToLower(info.Name()), ".json") {
-			return nil
-		}
-
-		// Get the relative path from the integration directory
-		relPath, err := filepath.Rel(integrationDir, path)
-		if err != nil {
-			return err
-		}
-
-		// Convert file path to module path
-		// e.g., "ec2/DescribeIdFormat.json" -> "AWS.ec2"
-		// The prefix is used for import organization but doesn't affect the directory structure
-		dir := filepath.Dir(relPath)
-		if dir == "." {
-			dir = ""
-		}
-
-		// Store the module path for imports and organization
-		// We've fixed the directories elsewhere, but we keep the module path format
-		// for compatibility with the rest of the code
-		modulePath := strings.ReplaceAll(integrationName, " ", "_")
-		if dir != "" {
-			dirPath := strings.ReplaceAll(dir, string(filepath.Separator), ".")
-			dirPath = strings.ReplaceAll(dirPath, " ", "_")
-			modulePath = fmt.Sprintf("%s.%s", modulePath, dirPath)
-		}
+	// Positional parameters come first, the others are keyword arguments
+	sort.SliceStable(pyOp.Parameters, func(i, j int) bool {
+		return pyOp.Parameters[i].Positional() && !pyOp.Parameters[j].Positional()
+	})
 
-		// Read and parse the flow file
-		fileContent, err := os.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("error reading file %s: %v", path, err)
-		}
+	if op.Output != nil {
+		pyOp.ReturnType = schemaTypeToPythonType(op.Output.Schema, nil, formats)
+		pyOp.Result = &op.Output.Schema
+		pyOp.Output = op.Output.Name
+	}
 
-		var flowFile FlowFile
-		if err := json.Unmarshal(fileContent, &flowFile); err != nil {
-			return fmt.Errorf("error parsing JSON from %s: %v", path, err)
-		}
+	return pyOp
+}
 
-		// Check if there's more than one process
-		if len(flowFile.Processes) != 1 {
-			return fmt.Errorf("file %s has %d processes, expected exactly 1", path, len(flowFile.Processes))
+// PositionalParameters returns the parameters a caller must pass, in order
+func (op Operation) PositionalParameters() []Parameter {
+	var params []Parameter
+	for _, p := range op.Parameters {
+		if p.Positional() {
+			params = append(params, p)
 		}
+	}
+	return params
+}
 
-		process := flowFile.Processes[0]
-
-		// Get operation name from the flow name
-		opName := sanitizeName(flowFile.Name)
-
-		// Create operation
-		op := Operation{
-			Name:        opName,
-			Parameters:  []Parameter{},
-			ReturnType:  "None", // Default return type
-			Description: flowFile.Meta.Info,
-			ModulePath:  modulePath,
-			FilePath:    path,
+// KeywordParameters returns the optional and defaulted parameters, which are keyword-only
+func (op Operation) KeywordParameters() []Parameter {
+	var params []Parameter
+	for _, p := range op.Parameters {
+		if !p.Positional() {
+			params = append(params, p)
 		}
+	}
+	return params
+}
 
-		// Process variables
-		for _, variable := range process.Variables {
-			// Process input parameters
-			if variable.IsInput {
-				param := Parameter{
-					Name:        sanitizeName(variable.Name),
-					Type:        jsonTypeToGoPythonType(variable.Type),
-					Required:    variable.Required,
-					Description: variable.Meta.Description,
-				}
-				op.Parameters = append(op.Parameters, param)
-			}
-
-			// Process output (return type)
-			if variable.IsOutput {
-				op.ReturnType = jsonTypeToGoPythonType(variable.Type)
-				// We could store more info about return type here if needed
-			}
-		}
+// Positional reports whether a parameter must be passed; optional parameters are keyword arguments
+func (p Parameter) Positional() bool {
+	return p.Required
+}
 
-		operations = append(operations, op)
-		return nil
-	})
+// MutableDefault reports whether the default is a list or dict, which is applied in
+// the function body instead of being shared between calls
+func (p Parameter) MutableDefault() bool {
+	return strings.HasPrefix(p.Default, "[") || strings.HasPrefix(p.Default, "{")
+}
 
-	if err != nil {
-		return nil, err
+// pythonLiteral converts a JSON value to a Python literal
+func pythonLiteral(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "None"
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		return pythonString(v)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, pythonLiteral(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]string, 0, len(v))
+		for _, key := range keys {
+			items = append(items, pythonString(key)+": "+pythonLiteral(v[key]))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
 	}
-
-	return operations, nil
+	// Other JSON numbers (e.g. json.Number) print as themselves
+	return fmt.Sprint(value)
 }
 
-// generatePythonStub creates a Python stub file for an operation using a template
-func generatePythonStub(op Operation, outPath string) error {
+// generatePythonStub creates the Python file of an operation using a template;
+// async files define a coroutine function that awaits the flow. With Pydantic models
+// the function validates its inputs, and results are converted to the model style.
+func generatePythonStub(op Operation, outPath string, async bool, opts Options) error {
 	// Read the template file
 	tmplPath := "templates/python_func.tmpl"
 	tmplContent, err := os.ReadFile(tmplPath)
@@ -1136,6 +846,7 @@ func generatePythonStub(op Operation, outPath string) error {
 	// Create a new template
 	tmpl, err := template.New("python_func").Funcs(template.FuncMap{
 		"split": strings.Split,
+		"pystr": pythonString,
 	}).Parse(string(tmplContent))
 	if err != nil {
 		return fmt.Errorf("failed to parse template: %v", err)
@@ -1143,12 +854,18 @@ func generatePythonStub(op Operation, outPath string) error {
 
 	// Create a template data structure
 	data := struct {
-		Op  Operation
-		Def struct {
+		Op      Operation
+		Async   bool
+		Models  string
+		Imports string
+		Def     struct {
 			Name string
 		}
 	}{
-		Op: op,
+		Op:      op,
+		Async:   async,
+		Models:  opts.Models,
+		Imports: formatImports(opts.Formats),
 	}
 
 	// Get the integration name from the module path
@@ -1176,6 +893,116 @@ func generatePythonStub(op Operation, outPath string) error {
 	return nil
 }
 
+// writeRuntime writes the _runtime module that runs flows on the Pliant server, and
+// exports its client settings from the integration package.
+// Async SDKs also get the _async_runtime module the aio package runs flows with.
+func writeRuntime(integration *ir.Integration, integrationDir string, async bool) error {
+	modules := map[string]string{"_runtime.py": "python_runtime"}
+	if async {
+		modules["_async_runtime.py"] = "python_async_runtime"
+	}
+
+	version := integration.Version
+	if version == "" {
+		version = "unknown"
+	}
+	data := struct {
+		Integration string
+		Package     string
+		Version     string
+	}{
+		Integration: integration.Name,
+		Package:     filepath.Base(integrationDir),
+		Version:     version,
+	}
+
+	for _, fileName := range sortedKeys(modules) {
+		tmplPath := "templates/" + modules[fileName] + ".tmpl"
+		tmplContent, err := os.ReadFile(tmplPath)
+		if err != nil {
+			return fmt.Errorf("failed to read template file %s: %v", tmplPath, err)
+		}
+
+		tmpl, err := template.New(modules[fileName]).Funcs(template.FuncMap{
+			"pystr": pythonString,
+		}).Parse(string(tmplContent))
+		if err != nil {
+			return fmt.Errorf("failed to parse template: %v", err)
+		}
+
+		var buffer bytes.Buffer
+		if err := tmpl.Execute(&buffer, data); err != nil {
+			return fmt.Errorf("failed to execute template: %v", err)
+		}
+
+		runtimePath := filepath.Join(integrationDir, fileName)
+		if err := os.WriteFile(runtimePath, buffer.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write file %s: %v", runtimePath, err)
+		}
+		fmt.Printf("- Generated runtime: %s\n", runtimePath)
+	}
+
+	initContent := "# Generated by LowCodeFusion\n"
+	initContent += "from ._runtime import PliantClient, PliantError, configure, default_client\n"
+	initPath := filepath.Join(integrationDir, "__init__.py")
+	if err := os.WriteFile(initPath, []byte(initContent), 0644); err != nil {
+		return fmt.Errorf("failed to write file %s: %v", initPath, err)
+	}
+
+	return nil
+}
+
+// isModelStyle reports whether a --python-models value is supported
+func isModelStyle(style string) bool {
+	for _, s := range modelStyles {
+		if s == style {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ensureTypesModules creates empty types modules of a service that an operation imports
+func ensureTypesModules(serviceTypesDir, operationName string) error {
+	if err := os.MkdirAll(serviceTypesDir, 0755); err != nil {
+		return fmt.Errorf("failed to create service types directory %s: %v", serviceTypesDir, err)
+	}
+	if err := createInitFile(filepath.Dir(serviceTypesDir)); err != nil {
+		return err
+	}
+	if err := createInitFile(serviceTypesDir); err != nil {
+		return err
+	}
+
+	for _, name := range []string{"common_types.py", operationName + "_types.py"} {
+		typesPath := filepath.Join(serviceTypesDir, name)
+		if _, err := os.Stat(typesPath); err == nil {
+			continue
+		}
+		if err := os.WriteFile(typesPath, []byte("# Generated by LowCodeFusion\n# No types\n"), 0644); err != nil {
+			return fmt.Errorf("failed to write types file %s: %v", typesPath, err)
+		}
+	}
+	return nil
+}
+
+// pythonString quotes a string as a Python string literal
+func pythonString(s string) string {
+	// JSON strings are valid Python literals
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
+
 // createInitFile creates __init__.py files in all parent directories
 func createInitFile(dirPath string) error {
 	// Create __init__.py file
@@ -1192,54 +1019,154 @@ func createInitFile(dirPath string) error {
 	return nil
 }
 
+// createPackageDirs creates a package and its subpackages along a path, each with an __init__.py
+func createPackageDirs(baseDir, subPath string) error {
+	dirPath := baseDir
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", dirPath, err)
+	}
+	if err := createInitFile(dirPath); err != nil {
+		return err
+	}
+	for _, part := range strings.Split(subPath, string(filepath.Separator)) {
+		if part == "" {
+			continue
+		}
+		dirPath = filepath.Join(dirPath, part)
+		if err := os.MkdirAll(dirPath, 0755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %v", dirPath, err)
+		}
+		if err := createInitFile(dirPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // analyzeComplexTypes examines operation parameters and return types to identify complex types
+// Parameters and return values with a registered type are annotated with its name.
 func analyzeComplexTypes(ops []Operation, registry *TypeRegistry) error {
-	for _, op := range ops {
+	for i := range ops {
+		op := &ops[i]
 		// Check for complex parameter types
-		for _, param := range op.Parameters {
-			// Only register Dict and List types that have specific formats
-			if strings.HasPrefix(param.Type, "Dict") || strings.HasPrefix(param.Type, "List") {
+		for j, param := range op.Parameters {
+			// Only register Dict, List and Union types, and types with definitions to generate
+			if isRegisteredType(param.Type, param.Schema) {
 				// Register this as a potential complex type
 				typeName := fmt.Sprintf("%s_%s_Type", op.Name, param.Name)
-				registry.RegisterType(
+				typeDef := registry.RegisterType(
 					typeName,
 					param.Type,
 					fmt.Sprintf("Type definition for parameter %s in %s", param.Name, op.Name),
 					op.FilePath,
 					op.ModulePath,
 					op.Name, // Pass operation name
+					declareInlineObjects(strings.TrimSuffix(typeName, "_Type"), param.Schema),
 				)
+				op.Parameters[j].Model = typeDef.Name
+				op.Parameters[j].Type = typeDef.Name
 			}
 		}
 
 		// Check for complex return type
-		if strings.HasPrefix(op.ReturnType, "Dict") || strings.HasPrefix(op.ReturnType, "List") {
+		if op.Result != nil && isRegisteredType(op.ReturnType, *op.Result) {
 			// Register this as a potential complex type
 			typeName := fmt.Sprintf("%s_Result_Type", op.Name)
-			registry.RegisterType(
+			typeDef := registry.RegisterType(
 				typeName,
 				op.ReturnType,
 				fmt.Sprintf("Type definition for return value of %s", op.Name),
 				op.FilePath,
 				op.ModulePath,
 				op.Name, // Pass operation name
+				declareInlineObjects(strings.TrimSuffix(typeName, "_Type"), *op.Result),
 			)
+			op.ResultModel = typeDef.Name
+			op.ReturnType = typeDef.Name
 		}
 	}
 
 	return nil
 }
 
+// isRegisteredType reports whether a parameter or return value gets a registered type
+func isRegisteredType(pythonType string, schema ir.Schema) bool {
+	for _, prefix := range []string{"Dict", "List", "Union"} {
+		if strings.HasPrefix(pythonType, prefix) {
+			return true
+		}
+	}
+	return len(schema.Definitions) > 0
+}
+
 // GenerateStubs scaffolds Python modules for the integration
 func GenerateStubs(def *fetcher.IntegrationDef, srcDir, outDir string) error {
 	// Parse operations from directory structure
-	ops, err := parseOperations(srcDir, def.Name)
+	integration, err := ir.Load(srcDir, def.Name)
 	if err != nil {
 		return err
 	}
+	integration.Version = def.Version
+
+	return Generate(integration, outDir, Options{})
+}
+
+// Options are the Python-specific generator settings
+type Options struct {
+	Async   bool                  // also generate async variants of the operations in the aio package
+	Models  string                // style of the generated types (TypedDictModels, PydanticModels or DataclassModels), TypedDictModels if empty
+	Formats generator.FormatTable // JSON Schema format -> Python type, DefaultFormats if nil; pydantic and dataclass models only
+}
+
+// DefaultFormats maps JSON Schema formats to Python types; other formats stay str.
+// bytes values are base64 strings in JSON.
+var DefaultFormats = generator.FormatTable{
+	"date-time": {Type: "datetime", Import: "datetime", JSONType: "string"},
+	"date":      {Type: "date", Import: "datetime", JSONType: "string"},
+	"time":      {Type: "time", Import: "datetime", JSONType: "string"},
+	"uuid":      {Type: "UUID", Import: "uuid", JSONType: "string"},
+	"uri":       {Type: "str", JSONType: "string"},
+	"email":     {Type: "str", JSONType: "string"},
+	"ipv4":      {Type: "IPv4Address", Import: "ipaddress", JSONType: "string"},
+	"ipv6":      {Type: "IPv6Address", Import: "ipaddress", JSONType: "string"},
+	"byte":      {Type: "bytes", JSONType: "string"},
+	"binary":    {Type: "bytes", JSONType: "string"},
+	"int32":     {Type: "int", JSONType: "integer"},
+	"int64":     {Type: "int", JSONType: "integer"},
+}
+
+// Generate writes Python modules for an integration:
+//
+//	<out>/<integration>/<service>/<Operation>.py      one function per operation
+//	<out>/<integration>/aio/<service>/<Operation>.py  async variants, if enabled
+//	<out>/<integration>/_types/<service>/             TypedDicts shared by both
+func Generate(integration *ir.Integration, outDir string, opts Options) error {
+	if opts.Models == "" {
+		opts.Models = TypedDictModels
+	}
+	if opts.Formats == nil {
+		opts.Formats = DefaultFormats
+	}
+	if opts.Models == TypedDictModels {
+		// TypedDict results are the JSON values the flow returns, so formats keep their JSON type
+		if !reflect.DeepEqual(opts.Formats, DefaultFormats) {
+			return fmt.Errorf("format types need pydantic or dataclass models, %s types keep the JSON types (set --python-models)", TypedDictModels)
+		}
+		opts.Formats = generator.FormatTable{}
+	}
+	if !isModelStyle(opts.Models) {
+		return fmt.Errorf("unsupported Python model style: %s (available: %s)", opts.Models, strings.Join(modelStyles, ", "))
+	}
+
+	var ops []Operation
+	for _, op := range integration.Operations() {
+		ops = append(ops, newOperation(integration.Name, op, opts.Formats))
+	}
 
 	// Create a type registry
 	typeRegistry := NewTypeRegistry(outDir)
+	typeRegistry.Models = opts.Models
+	typeRegistry.Formats = opts.Formats
 
 	// Analyze operations for complex types
 	if err := analyzeComplexTypes(ops, typeRegistry); err != nil {
@@ -1248,11 +1175,11 @@ func GenerateStubs(def *fetcher.IntegrationDef, srcDir, outDir string) error {
 
 	// Create the base integration directory
 
-	integrationDir := filepath.Join(outDir, def.Name)
+	integrationDir := filepath.Join(outDir, integration.Name)
 	if err := os.MkdirAll(integrationDir, 0755); err != nil {
 		return fmt.Errorf("failed to create integration directory %s: %v", integrationDir, err)
 	}
-	if err := createInitFile(integrationDir); err != nil {
+	if err := writeRuntime(integration, integrationDir, opts.Async); err != nil {
 		return err
 	}
 
@@ -1292,28 +1219,39 @@ func GenerateStubs(def *fetcher.IntegrationDef, srcDir, outDir string) error {
 		opFilePath := filepath.Join(opDirPath, fmt.Sprintf("%s.py", op.Name))
 
 		// Create __init__.py files in all parent directories
-		dirPath := integrationDir
-		for _, part := range strings.Split(servicePath, string(filepath.Separator)) {
-			if part == "" {
-				continue
-			}
-			dirPath = filepath.Join(dirPath, part)
-			if err := os.MkdirAll(dirPath, 0755); err != nil {
-				return fmt.Errorf("failed to create directory %s: %v", dirPath, err)
-			}
-			if err := createInitFile(dirPath); err != nil {
-				return err
-			}
+		if err := createPackageDirs(integrationDir, servicePath); err != nil {
+			return err
 		}
 
-		// Generate Python stub file
-		if err := generatePythonStub(op, opFilePath); err != nil {
+		// The function imports its types modules, which only exist if the registry found types
+		if err := ensureTypesModules(filepath.Join(integrationDir, "_types", op.TypesModule), op.Name); err != nil {
+			return err
+		}
+
+		// Generate Python function file
+		if err := generatePythonStub(op, opFilePath, false, opts); err != nil {
 			return err
 		}
 
 		fmt.Printf("  - Generated: %s\n", opFilePath)
+
+		// The async variant lives one package deeper, in the aio package
+		if opts.Async {
+			asyncOp := op
+			asyncOp.Parent += "."
+			asyncDir := filepath.Join(integrationDir, "aio")
+			if err := createPackageDirs(asyncDir, servicePath); err != nil {
+				return err
+			}
+			asyncFilePath := filepath.Join(asyncDir, servicePath, fmt.Sprintf("%s.py", op.Name))
+			if err := generatePythonStub(asyncOp, asyncFilePath, true, opts); err != nil {
+				return err
+			}
+
+			fmt.Printf("  - Generated: %s\n", asyncFilePath)
+		}
 	}
 
-	fmt.Printf("\nSuccessfully generated %d Python stub files\n", len(ops))
+	fmt.Printf("\nSuccessfully generated %d Python operation files\n", len(ops))
 	return nil
 }
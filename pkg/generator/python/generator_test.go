@@ -0,0 +1,62 @@
+// File: pkg/generator/python/generator_test.go
+
+package python
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/generator/generatortest"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+func TestGenerateGolden(t *testing.T) {
+	generatortest.Run(t, map[string]generator.Generator{
+		"typeddict": &Generator{},
+		"async":     &Generator{opts: Options{Async: true}},
+		"pydantic":  &Generator{opts: Options{Models: PydanticModels}},
+		"dataclass": &Generator{opts: Options{Models: DataclassModels}},
+		"formats":   &Generator{opts: Options{Models: DataclassModels, Formats: overrideFormats(t, "uuid=str,decimal=Decimal@decimal")}},
+	})
+}
+
+func TestFormatFlagsShareTable(t *testing.T) {
+	// download and generate both bind the flags of the registered generator
+	g := &Generator{opts: Options{Formats: DefaultFormats.Clone()}}
+	download := pflag.NewFlagSet("download", pflag.ContinueOnError)
+	generate := pflag.NewFlagSet("generate", pflag.ContinueOnError)
+	g.Flags(download)
+	g.Flags(generate)
+
+	if err := download.Parse([]string{"--python-format", "uuid=str"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := g.opts.Formats["uuid"].Type; got != "str" {
+		t.Errorf("uuid = %s after --python-format on download, want str", got)
+	}
+	if DefaultFormats["uuid"].Type != "UUID" {
+		t.Error("--python-format changed DefaultFormats")
+	}
+}
+
+func TestTypedDictRejectsFormats(t *testing.T) {
+	integration := &ir.Integration{Name: "Demo"}
+	opts := Options{Models: TypedDictModels, Formats: overrideFormats(t, "uuid=str")}
+	err := Generate(integration, t.TempDir(), opts)
+	if err == nil || !strings.Contains(err.Error(), "--python-models") {
+		t.Errorf("Generate = %v, want an error asking for --python-models", err)
+	}
+}
+
+// overrideFormats returns the default formats with format=Type[@module] overrides applied
+func overrideFormats(t *testing.T, overrides string) generator.FormatTable {
+	t.Helper()
+	formats := DefaultFormats.Clone()
+	if err := formats.Set(overrides); err != nil {
+		t.Fatal(err)
+	}
+	return formats
+}
@@ -0,0 +1,198 @@
+// File: pkg/generator/python/models.go
+
+package python
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Model styles of the generated Python types
+const (
+	TypedDictModels = "typeddict" // TypedDict(total=False) classes, plain dicts at runtime
+	PydanticModels  = "pydantic"  // Pydantic v2 models that validate inputs before they are sent
+	DataclassModels = "dataclass" // dataclasses with to_dict/from_dict, no dependencies
+)
+
+// modelStyles lists the accepted values of --python-models
+var modelStyles = []string{TypedDictModels, PydanticModels, DataclassModels}
+
+// pythonKeywords cannot be used as field names
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true,
+	"async": true, "await": true, "break": true, "class": true, "continue": true, "def": true,
+	"del": true, "elif": true, "else": true, "except": true, "finally": true, "for": true,
+	"from": true, "global": true, "if": true, "import": true, "in": true, "is": true,
+	"lambda": true, "nonlocal": true, "not": true, "or": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// templateNames are the names the body of a generated operation uses, which parameters must not shadow
+var templateNames = map[string]bool{
+	"inputs": true, "result": true, "_execute": true, "_from_value": true,
+	"PliantClient": true, "TypeAdapter": true,
+}
+
+// typesModuleImports are the names every types module imports, besides the format types
+var typesModuleImports = map[string]bool{
+	"Any": true, "Dict": true, "List": true, "Literal": true, "Optional": true,
+	"TypedDict": true, "Union": true,
+}
+
+// formatImports renders the imports of the format types: "from module import Type",
+// or "import module" for dotted types such as "decimal.Decimal"
+func formatImports(formats generator.FormatTable) string {
+	names := make(map[string]map[string]bool) // module -> imported names
+	modules := make(map[string]bool)          // modules imported as a whole
+	for _, format := range formats.Formats() {
+		ft := formats[format]
+		switch {
+		case ft.Import == "":
+		case strings.Contains(ft.Type, "."):
+			modules[ft.Import] = true
+		default:
+			if names[ft.Import] == nil {
+				names[ft.Import] = make(map[string]bool)
+			}
+			names[ft.Import][ft.Type] = true
+		}
+	}
+
+	result := ""
+	for _, module := range sortedSet(modules) {
+		result += fmt.Sprintf("import %s\n", module)
+	}
+	fromModules := make([]string, 0, len(names))
+	for module := range names {
+		fromModules = append(fromModules, module)
+	}
+	sort.Strings(fromModules)
+	for _, module := range fromModules {
+		result += fmt.Sprintf("from %s import %s\n", module, strings.Join(sortedSet(names[module]), ", "))
+	}
+	return result
+}
+
+// withFormatTypes returns the reserved names of a model style plus the imported format types,
+// which a field must not shadow either
+func withFormatTypes(reserved map[string]bool, formats generator.FormatTable) map[string]bool {
+	result := make(map[string]bool, len(reserved)+len(formats))
+	for name := range reserved {
+		result[name] = true
+	}
+	for _, ft := range formats {
+		if ft.Import != "" && !strings.Contains(ft.Type, ".") {
+			result[ft.Type] = true
+		}
+	}
+	return result
+}
+
+// sortedSet returns the members of a set, sorted
+func sortedSet(set map[string]bool) []string {
+	members := make([]string, 0, len(set))
+	for member := range set {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// pythonFieldName converts a property name to a field name a class can declare
+// (e.g. "content-type" -> "content_type", "class" -> "class_").
+// reserved lists further names the class must not shadow.
+func pythonFieldName(propName, pythonType string, reserved map[string]bool) string {
+	// Leading underscores would make the field private
+	name := strings.TrimLeft(sanitizeName(propName), "_")
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "field_" + name
+	}
+	if pythonKeywords[name] || typesModuleImports[name] || reserved[name] {
+		name += "_"
+	}
+
+	// A field cannot share its name with a type in its annotation
+	if regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`).MatchString(pythonType) {
+		name += "_"
+	}
+	return name
+}
+
+// declareInlineObjects moves the inline object schemas nested in a registered type
+// (properties, array items, map values, union variants) into its definitions, so they
+// get classes of their own. base names them (e.g. "ListBuckets_Result" declares
+// "ListBuckets_Result_Item_Type" for the objects of a list).
+func declareInlineObjects(base string, schema ir.Schema) ir.Schema {
+	defs := make(map[string]ir.Schema, len(schema.Definitions))
+	for defName, defSchema := range schema.Definitions {
+		defs[defName] = defSchema
+	}
+	for _, defName := range schema.DefinitionNames() {
+		defs[defName] = hoistObjects(defName, schema.Definitions[defName], defs)
+	}
+	schema = hoistObjects(base, schema, defs)
+	schema.Definitions = defs
+	return schema
+}
+
+// hoistObjects replaces the inline objects nested in a schema by references to new definitions
+func hoistObjects(base string, schema ir.Schema, defs map[string]ir.Schema) ir.Schema {
+	nested := func(part string, s ir.Schema) ir.Schema {
+		if s.Ref != "" {
+			return s
+		}
+		s = hoistObjects(base+"_"+sanitizeName(part), s, defs)
+		if s.Type != "object" || len(s.Properties) == 0 {
+			return s
+		}
+
+		name := base + "_" + sanitizeName(part) + "_Type"
+		for i := 2; ; i++ {
+			if _, taken := defs[name]; !taken {
+				break
+			}
+			name = fmt.Sprintf("%s_%s%d_Type", base, sanitizeName(part), i)
+		}
+		ref := ir.Schema{
+			Name:        s.Name,
+			Type:        s.Type,
+			Description: s.Description,
+			Ref:         "#/definitions/" + name,
+			Default:     s.Default,
+		}
+		s.Name = name
+		s.Definitions = nil
+		defs[name] = s
+		return ref
+	}
+
+	if len(schema.Properties) > 0 {
+		props := make(map[string]ir.Schema, len(schema.Properties))
+		for _, propName := range schema.PropertyNames() {
+			props[propName] = nested(propName, schema.Properties[propName])
+		}
+		schema.Properties = props
+	}
+	if schema.Items != nil {
+		items := nested("Item", *schema.Items)
+		schema.Items = &items
+	}
+	if schema.AdditionalProperties != nil {
+		values := nested("Value", *schema.AdditionalProperties)
+		schema.AdditionalProperties = &values
+	}
+	if len(schema.OneOf) > 0 {
+		variants := make([]ir.Schema, 0, len(schema.OneOf))
+		for i, variant := range schema.OneOf {
+			variants = append(variants, nested(fmt.Sprintf("Option%d", i+1), variant))
+		}
+		schema.OneOf = variants
+	}
+	return schema
+}
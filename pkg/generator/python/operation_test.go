@@ -0,0 +1,136 @@
+// File: pkg/generator/python/operation_test.go
+
+package python
+
+import (
+	"testing"
+
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+func TestSchemaTypeToPythonType(t *testing.T) {
+	str := ir.Schema{Type: "string"}
+	tests := []struct {
+		schema ir.Schema
+		want   string
+	}{
+		{str, "str"},
+		{ir.Schema{Type: "integer"}, "int"},
+		{ir.Schema{Type: "number"}, "float"},
+		{ir.Schema{Type: "boolean"}, "bool"},
+		{ir.Schema{Type: "string", Enum: []string{"a", "b"}}, `Literal["a", "b"]`},
+		{ir.Schema{Type: "integer", Enum: []string{"1", "2"}}, "Literal[1, 2]"},
+		{ir.Schema{Type: "string", Format: "date-time"}, "datetime"},
+		{ir.Schema{Type: "string", Format: "email"}, "str"},
+		{ir.Schema{Type: "array", Items: &str}, "List[str]"},
+		{ir.Schema{Type: "map", AdditionalProperties: &str}, "Dict[str, str]"},
+		{ir.Schema{Ref: "#/definitions/Tag", Type: "object"}, "Tag"},
+	}
+	for _, tt := range tests {
+		if got := schemaTypeToPythonType(tt.schema, nil, DefaultFormats); got != tt.want {
+			t.Errorf("schemaTypeToPythonType(%s) = %s, want %s", tt.schema, got, tt.want)
+		}
+	}
+}
+
+func TestAnalyzeComplexTypes(t *testing.T) {
+	object := ir.Schema{Type: "object", Properties: map[string]ir.Schema{"Key": {Name: "Key", Type: "string"}}}
+	op := &ir.Operation{
+		Name: "TagResource",
+		Path: []string{"ec2"},
+		Inputs: []*ir.Parameter{
+			{Name: "ResourceId", Required: true, Schema: ir.Schema{Type: "string"}},
+			{Name: "Tags", Required: true, Schema: ir.Schema{Type: "array", Items: &object}},
+		},
+		Output: &ir.Parameter{Name: "result", Schema: object},
+	}
+	ops := []Operation{newOperation("AWS", op, DefaultFormats)}
+	if err := analyzeComplexTypes(ops, NewTypeRegistry(t.TempDir())); err != nil {
+		t.Fatal(err)
+	}
+
+	// Scalars keep their Python type, complex values are annotated with their registered type
+	params := ops[0].Parameters
+	if params[0].Type != "str" || params[0].Model != "" {
+		t.Errorf("ResourceId = %s (model %q), want str", params[0].Type, params[0].Model)
+	}
+	if params[1].Type != "TagResource_Tags_Type" || params[1].Model != params[1].Type {
+		t.Errorf("Tags = %s (model %q), want TagResource_Tags_Type", params[1].Type, params[1].Model)
+	}
+	if ops[0].ReturnType != "TagResource_Result_Type" || ops[0].ResultModel != ops[0].ReturnType {
+		t.Errorf("return type = %s (model %q), want TagResource_Result_Type", ops[0].ReturnType, ops[0].ResultModel)
+	}
+}
+
+func TestNewOperationParameters(t *testing.T) {
+	op := &ir.Operation{
+		Name: "Clash",
+		Inputs: []*ir.Parameter{
+			{Name: "class", Schema: ir.Schema{Type: "string"}},
+			{Name: "inputs", Required: true, Schema: ir.Schema{Type: "string"}},
+			{Name: "result", Schema: ir.Schema{Type: "string"}},
+			{Name: "client", Schema: ir.Schema{Type: "string"}},
+			{Name: "count", Required: true, Schema: ir.Schema{Type: "integer", Default: 3.0}},
+			{Name: "tags", Schema: ir.Schema{Type: "array", Default: []interface{}{"a"}}},
+			{Name: "enabled", Schema: ir.Schema{Type: "boolean", Default: true}},
+		},
+	}
+	pyOp := newOperation("Demo", op, DefaultFormats)
+
+	type param struct {
+		name, key, def string
+		positional     bool
+	}
+	want := []param{
+		// Required inputs are positional in flow order, even with a default
+		{"inputs_", "inputs", "", true},
+		{"count", "count", "", true},
+		// Keywords and names the function body uses are renamed, the flow key stays
+		{"class_", "class", "", false},
+		{"result_", "result", "", false},
+		{"client", "client", "", false},
+		{"tags", "tags", `["a"]`, false},
+		{"enabled", "enabled", "True", false},
+	}
+	if len(pyOp.Parameters) != len(want) {
+		t.Fatalf("%d parameters, want %d", len(pyOp.Parameters), len(want))
+	}
+	for i, p := range pyOp.Parameters {
+		got := param{p.Name, p.Key, p.Default, p.Positional()}
+		if got != want[i] {
+			t.Errorf("parameter %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+	if !pyOp.Parameters[5].MutableDefault() || pyOp.Parameters[6].MutableDefault() {
+		t.Error("only list and dict defaults are mutable")
+	}
+
+	// The client argument makes way for an input of the same name
+	if pyOp.ClientParam != "pliant_client" {
+		t.Errorf("ClientParam = %s, want pliant_client", pyOp.ClientParam)
+	}
+	if len(pyOp.PositionalParameters()) != 2 || len(pyOp.KeywordParameters()) != 5 {
+		t.Errorf("%d positional and %d keyword parameters", len(pyOp.PositionalParameters()), len(pyOp.KeywordParameters()))
+	}
+}
+
+func TestPythonLiteral(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "None"},
+		{true, "True"},
+		{false, "False"},
+		{3.0, "3"},
+		{0.5, "0.5"},
+		{"it's", `"it's"`},
+		{[]interface{}{"a", 1.0}, `["a", 1]`},
+		{map[string]interface{}{"b": false, "a": nil}, `{"a": None, "b": False}`},
+	}
+	for _, tt := range tests {
+		if got := pythonLiteral(tt.value); got != tt.want {
+			t.Errorf("pythonLiteral(%#v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// File: pkg/generator/python/pydantic.go
+
+package python
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// baseModelAttributes are attributes of pydantic.BaseModel and imported names a field must not shadow
+var baseModelAttributes = map[string]bool{
+	"construct": true, "copy": true, "dict": true, "from_orm": true, "json": true,
+	"parse_file": true, "parse_obj": true, "parse_raw": true, "schema": true, "schema_json": true,
+	"update_forward_refs": true, "validate": true,
+	"BaseModel": true, "ConfigDict": true, "Field": true,
+}
+
+// pydanticImports are the imports of types modules with Pydantic models
+const pydanticImports = "from pydantic import BaseModel, ConfigDict, Field\n"
+
+// generatePydanticModel generates a Pydantic v2 model for an object schema.
+// Properties that are not Python identifiers become fields with an alias, the
+// schema's required list decides which fields must be set.
+func generatePydanticModel(schema ir.Schema, rootTypes map[string]bool, formats generator.FormatTable) string {
+	result := fmt.Sprintf("class %s(BaseModel):\n", schema.Name)
+	if schema.Description != "" {
+		result += fmt.Sprintf("    \"\"\"%s\"\"\"\n\n", schema.Description)
+	}
+
+	// Fields can be set by their Python names as well as by the property names
+	config := []string{"populate_by_name=True", "protected_namespaces=()"}
+	extra := ""
+	if schema.AdditionalProperties != nil {
+		// Other keys are kept as extra fields, validated against the value type. Pydantic
+		// resolves that type when the class is created unless the build is deferred to
+		// first use, by which time types defined later in the module exist.
+		config = append(config, `extra="allow"`, "defer_build=True")
+		extra = schemaTypeToPythonType(*schema.AdditionalProperties, rootTypes, formats)
+	}
+	fields, usesBytes := pydanticFields(schema, rootTypes, formats)
+	// bytes values are base64 strings in JSON, not UTF-8 text
+	if usesBytes || bytesTypeRe.MatchString(extra) {
+		config = append(config, `ser_json_bytes="base64"`, `val_json_bytes="base64"`)
+	}
+	result += fmt.Sprintf("    model_config = ConfigDict(%s)\n", strings.Join(config, ", "))
+	if extra != "" {
+		result += fmt.Sprintf("    __pydantic_extra__: Dict[str, %s] = Field(init=False)\n", extra)
+	}
+	return result + fields
+}
+
+// bytesTypeRe matches type annotations involving bytes
+var bytesTypeRe = regexp.MustCompile(`\bbytes\b`)
+
+// pydanticFields renders the field declarations of a Pydantic model and reports
+// whether any field holds bytes
+func pydanticFields(schema ir.Schema, rootTypes map[string]bool, formats generator.FormatTable) (string, bool) {
+	result := ""
+	usesBytes := false
+	reserved := withFormatTypes(baseModelAttributes, formats)
+	usedNames := make(map[string]bool)
+	for _, propName := range schema.PropertyNames() {
+		propType := schema.Properties[propName]
+		pythonType := schemaTypeToPythonType(propType, rootTypes, formats)
+		usesBytes = usesBytes || bytesTypeRe.MatchString(pythonType)
+
+		fieldName := pythonFieldName(propName, pythonType, reserved)
+		// Pydantic reserves the model_ prefix
+		if strings.HasPrefix(fieldName, "model_") {
+			fieldName += "_"
+		}
+		for usedNames[fieldName] {
+			fieldName += "_"
+		}
+		usedNames[fieldName] = true
+
+		var args []string
+		if !schema.IsRequired(propName) {
+			pythonType = fmt.Sprintf("Optional[%s]", pythonType)
+			args = append(args, "default=None")
+		}
+		if fieldName != propName {
+			args = append(args, "alias="+pythonString(propName))
+		}
+		if propType.Description != "" {
+			args = append(args, "description="+pythonString(propType.Description))
+		}
+
+		switch {
+		case len(args) == 0:
+			result += fmt.Sprintf("    %s: %s\n", fieldName, pythonType)
+		case len(args) == 1 && args[0] == "default=None":
+			result += fmt.Sprintf("    %s: %s = None\n", fieldName, pythonType)
+		default:
+			result += fmt.Sprintf("    %s: %s = Field(%s)\n", fieldName, pythonType, strings.Join(args, ", "))
+		}
+	}
+
+	return result + "\n", usesBytes
+}
@@ -0,0 +1,50 @@
+// File: pkg/generator/python/python.go
+
+package python
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Generator is the Python target of lcf generate --lang python
+type Generator struct {
+	opts Options
+}
+
+func init() {
+	generator.Register(&Generator{opts: Options{Formats: DefaultFormats.Clone()}})
+}
+
+// Name selects the generator with --lang
+func (g *Generator) Name() string {
+	return "python"
+}
+
+// Description summarizes the generated SDK
+func (g *Generator) Description() string {
+	return "Python package with TypedDict types and a urllib client runtime"
+}
+
+// Capabilities lists the optional features of the Python SDK
+func (g *Generator) Capabilities() []generator.Capability {
+	return []generator.Capability{generator.TypedModels, generator.Runtime, generator.Async}
+}
+
+// Flags registers the Python-specific flags
+func (g *Generator) Flags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&g.opts.Async, "python-async", "", false, "Also generate async variants of the operations in the aio package")
+	fs.StringVarP(&g.opts.Models, "python-models", "", TypedDictModels, "Style of the generated Python types: "+strings.Join(modelStyles, ", "))
+	fs.Var(g.opts.Formats, "python-format", "Python type of a JSON Schema format in pydantic and dataclass models, as format=Type[@module] (repeatable)")
+	generator.MarkCapability(fs, "python-async", generator.Async)
+	generator.MarkCapability(fs, "python-models", generator.TypedModels)
+}
+
+// Generate writes the Python SDK for an integration
+func (g *Generator) Generate(integration *ir.Integration, outDir string) error {
+	return Generate(integration, outDir, g.opts)
+}
@@ -0,0 +1,25 @@
+# Generated by LowCodeFusion
+"""Asynchronous transport of the Demo SDK.
+
+The async operations in Demo.aio share the client settings of _runtime:
+configure the default client once and await the operations:
+
+    import Demo
+    Demo.configure(base_url="https://pliant.example.com", token="...")
+
+Requests go through the same urllib transport as the blocking operations, run in the
+event loop's default executor, so proxies, redirects and TLS settings behave the same.
+"""
+import asyncio
+import functools
+from typing import Any, Mapping, Optional
+
+from ._runtime import PliantClient, default_client, select_output
+
+
+async def execute(flow: str, inputs: Mapping[str, Any], output: Optional[str] = None, client: Optional[PliantClient] = None) -> Any:
+    """Runs a flow without blocking the event loop and returns the named output variable, or the whole output."""
+    client = client or default_client()
+    loop = asyncio.get_running_loop()
+    result = await loop.run_in_executor(None, functools.partial(client.execute, flow, dict(inputs)))
+    return select_output(result, output)
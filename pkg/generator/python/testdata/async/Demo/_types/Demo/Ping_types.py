@@ -0,0 +1,2 @@
+# Generated by LowCodeFusion
+# No types
@@ -0,0 +1,35 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from .common_types import *  # Import service common types
+
+# Type definition for return value of Probe
+# From: pkg/generator/testdata/flows/Demo/net/Probe.json
+class Probe_Result_Type(TypedDict, total=False):
+    addr: Optional[str]
+    day: Optional[str]
+    id: Optional[str]
+    latency: Optional[float]
+    time: Optional[str]
+
+# Type definition for parameter host in Probe
+# From: pkg/generator/testdata/flows/Demo/net/Probe.json
+class Probe_host_Type(TypedDict, total=False):
+    addr: Optional[str]
+    addr6: Optional[str]
+    big: Optional[int]
+    bigstr: Optional[str]
+    blob: Optional[str]
+    d: Optional[float]
+    day: Optional[str]
+    f: Optional[float]
+    id: str
+    mail: Optional[str]
+    price: Optional[float]
+    ratio: Optional[float]
+    seen: Optional[str]
+    site: Optional[str]
+    small: Optional[int]
+    time: Optional[str]
+
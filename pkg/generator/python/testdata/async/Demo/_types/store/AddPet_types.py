@@ -0,0 +1,39 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from .common_types import *  # Import service common types
+
+class Kitten(TypedDict, total=False):
+    kind: Optional[Literal["c"]]
+    lives: Optional[int]
+
+class Puppy(TypedDict, total=False):
+    bark: Optional[bool]
+    kind: Optional[Literal["d"]]
+
+class Base(TypedDict, total=False):
+    kind: Optional[str]
+    name: str
+
+class Cat(TypedDict, total=False):
+    """A cat"""
+    kind: Literal["Cat"]
+    lives: Optional[int]
+    name: str
+
+class Dog(TypedDict, total=False):
+    bark: Optional[Union[str, bool, List[Union[int, str]]]]
+    kind: Optional[Literal["Dog"]]
+    name: str
+
+Alias = Base
+
+# Type definition for return value of AddPet
+# From: pkg/generator/testdata/flows/Demo/store/AddPet.json
+AddPet_Result_Type = Union[Kitten, Puppy]
+
+# Type definition for parameter pet in AddPet
+# From: pkg/generator/testdata/flows/Demo/store/AddPet.json
+AddPet_pet_Type = Union[Cat, Dog]
+
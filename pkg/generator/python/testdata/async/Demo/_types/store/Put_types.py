@@ -0,0 +1,45 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from .common_types import *  # Import service common types
+
+class Put_Result_Value_Type(TypedDict, total=False):
+    v: Optional[int]
+
+# Type definition for parameter config in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+class Put_config_Type(TypedDict, total=False):
+    # Other keys: Setting
+    name: str
+
+class Setting(TypedDict, total=False):
+    v: Optional[int]
+
+# Type definition for parameter nested in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+class Put_nested_Type(TypedDict, total=False):
+    m: Optional[Dict[str, List[str]]]
+
+Tree = Dict[str, Any]
+
+# Type definition for return value of Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_Result_Type = Dict[str, Put_Result_Value_Type]
+
+# Type definition for parameter anything in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_anything_Type = Dict[str, Any]
+
+# Type definition for parameter counts in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_counts_Type = Dict[str, Union[int, str]]
+
+# Type definition for parameter labels in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_labels_Type = Dict[str, str]
+
+# Type definition for parameter tree in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_tree_Type = Tree
+
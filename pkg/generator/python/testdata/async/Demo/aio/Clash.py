@@ -0,0 +1,39 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/Clash flow on the Pliant server without blocking the event loop.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+
+from .._async_runtime import PliantClient, execute as _execute
+
+# Import service-specific common types
+from .._types.Demo.common_types import *
+
+# Import operation-specific types
+from .._types.Demo.Clash_types import *
+
+
+async def Clash(
+    inputs_: str,
+    count: int,
+    *,
+    result_: Optional[str] = None,
+    client: Optional[str] = None,
+    tags: Optional[Clash_tags_Type] = None,
+    pliant_client: Optional[PliantClient] = None,
+) -> str:
+    """names"""
+    if tags is None:
+        tags = ["a"]
+    inputs: Dict[str, Any] = {
+        "inputs": inputs_,
+        "count": count,
+    }
+    if result_ is not None:
+        inputs["result"] = result_
+    if client is not None:
+        inputs["client"] = client
+    if tags is not None:
+        inputs["tags"] = tags
+    return await _execute("Demo/Clash", inputs, output="out", client=pliant_client)
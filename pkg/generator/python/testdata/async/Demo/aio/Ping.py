@@ -0,0 +1,23 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/Ping flow on the Pliant server without blocking the event loop.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+
+from .._async_runtime import PliantClient, execute as _execute
+
+# Import service-specific common types
+from .._types.Demo.common_types import *
+
+# Import operation-specific types
+from .._types.Demo.Ping_types import *
+
+
+async def Ping(
+    *,
+    client: Optional[PliantClient] = None,
+) -> None:
+    """Checks that the integration is reachable"""
+    inputs: Dict[str, Any] = {}
+    await _execute("Demo/Ping", inputs, output=None, client=client)
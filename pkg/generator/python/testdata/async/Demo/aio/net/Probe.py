@@ -0,0 +1,29 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/net/Probe flow on the Pliant server without blocking the event loop.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+
+from ..._async_runtime import PliantClient, execute as _execute
+
+# Import service-specific common types
+from ..._types.net.common_types import *
+
+# Import operation-specific types
+from ..._types.net.Probe_types import *
+
+
+async def Probe(
+    host: Probe_host_Type,
+    *,
+    threshold: float = 0.5,
+    client: Optional[PliantClient] = None,
+) -> Probe_Result_Type:
+    """Probes a host"""
+    inputs: Dict[str, Any] = {
+        "host": host,
+    }
+    if threshold is not None:
+        inputs["threshold"] = threshold
+    return await _execute("Demo/net/Probe", inputs, output="result", client=client)
@@ -0,0 +1,29 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/store/AddPet flow on the Pliant server.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+
+from .._runtime import PliantClient, execute as _execute
+
+# Import service-specific common types
+from .._types.store.common_types import *
+
+# Import operation-specific types
+from .._types.store.AddPet_types import *
+
+
+def AddPet(
+    pet: AddPet_pet_Type,
+    *,
+    tags: Optional[Any] = None,
+    client: Optional[PliantClient] = None,
+) -> AddPet_Result_Type:
+    """Adds a pet"""
+    inputs: Dict[str, Any] = {
+        "pet": pet,
+    }
+    if tags is not None:
+        inputs["tags"] = tags
+    return _execute("Demo/store/AddPet", inputs, output="result", client=client)
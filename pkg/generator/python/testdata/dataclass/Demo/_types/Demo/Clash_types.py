@@ -0,0 +1,16 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from dataclasses import dataclass, field
+from typing import Mapping
+from .._dataclass import from_dict as _from_dict, to_dict as _to_dict
+from .common_types import *  # Import service common types
+
+# Type definition for parameter tags in Clash
+# From: pkg/generator/testdata/flows/Demo/Clash.json
+Clash_tags_Type = List[str]
+
@@ -0,0 +1,125 @@
+# Generated by LowCodeFusion
+"""Conversion between the generated dataclasses and plain JSON values.
+
+Fields are converted according to their type hints, so nested dataclasses,
+lists of dataclasses, dates, times, UUIDs, IP addresses and bytes (base64 in
+JSON) round-trip through to_dict and from_dict. Other format types (e.g. decimal.Decimal) are built by
+calling the type with the JSON value and sent back as strings.
+"""
+import base64
+import dataclasses
+import typing
+from datetime import date, datetime, time
+from typing import Any, Callable, Dict, Mapping, Type, TypeVar
+
+T = TypeVar("T")
+
+# Types of JSON values, which are used as they are
+_JSON_TYPES = (str, int, float, bool)
+
+# Parsers of the JSON strings of types that cannot be built by calling the type
+_PARSERS: Dict[Any, Callable[[str], Any]] = {
+    datetime: lambda value: datetime.fromisoformat(value.replace("Z", "+00:00")),
+    date: date.fromisoformat,
+    time: lambda value: time.fromisoformat(value.replace("Z", "+00:00")),
+    bytes: base64.b64decode,
+}
+
+
+def to_dict(obj: Any) -> Dict[str, Any]:
+    """Converts a dataclass to a dict keyed by property names; unset (None) fields are left out."""
+    result: Dict[str, Any] = {}
+    for f in dataclasses.fields(obj):
+        value = getattr(obj, f.name)
+        if f.metadata.get("additional"):
+            # Other keys are merged into the object, listed properties take precedence
+            for key, item in value.items():
+                result.setdefault(key, to_value(item))
+        elif value is not None:
+            result[f.metadata.get("name", f.name)] = to_value(value)
+    return result
+
+
+def to_value(value: Any) -> Any:
+    """Converts dataclasses, and the values nested in lists and dicts, to JSON values."""
+    if dataclasses.is_dataclass(value) and not isinstance(value, type):
+        return to_dict(value)
+    if isinstance(value, (list, tuple)):
+        return [to_value(item) for item in value]
+    if isinstance(value, dict):
+        return {key: to_value(item) for key, item in value.items()}
+    if isinstance(value, (datetime, date, time)):
+        return value.isoformat()
+    if isinstance(value, (bytes, bytearray)):
+        return base64.b64encode(value).decode("ascii")
+    if value is None or isinstance(value, _JSON_TYPES):
+        return value
+    # Format types such as UUID, IPv4Address or Decimal
+    return str(value)
+
+
+def from_dict(cls: Type[T], data: Mapping[str, Any]) -> T:
+    """Creates a dataclass from a dict keyed by property names; unknown properties are ignored
+    unless the class collects them in an additional field."""
+    hints = typing.get_type_hints(cls)
+    values = {}
+    listed = set()
+    additional = None
+    for f in dataclasses.fields(cls):
+        if f.metadata.get("additional"):
+            additional = f
+            continue
+        name = f.metadata.get("name", f.name)
+        listed.add(name)
+        if name in data:
+            values[f.name] = from_value(hints.get(f.name, Any), data[name])
+    if additional is not None:
+        values[additional.name] = from_value(
+            hints.get(additional.name, Any), {key: item for key, item in data.items() if key not in listed}
+        )
+    return cls(**values)
+
+
+def from_value(hint: Any, value: Any) -> Any:
+    """Converts a JSON value to the type of a field."""
+    if value is None:
+        return None
+
+    origin = typing.get_origin(hint)
+    args = typing.get_args(hint)
+    if origin is typing.Union:
+        # Optional[X] and unions take the first type the value converts to
+        for arg in args:
+            if arg is type(None):
+                continue
+            try:
+                return from_value(arg, value)
+            except (TypeError, ValueError):
+                continue
+        raise ValueError(f"{value!r} does not match {hint}")
+    if origin is typing.Literal:
+        # Discriminator properties tell the variants of a union apart
+        if value not in args:
+            raise ValueError(f"{value!r} is not one of {args}")
+        return value
+    if origin is list and isinstance(value, list):
+        return [from_value(args[0] if args else Any, item) for item in value]
+    if origin is dict and isinstance(value, Mapping):
+        return {key: from_value(args[1] if len(args) > 1 else Any, item) for key, item in value.items()}
+    if isinstance(hint, type) and dataclasses.is_dataclass(hint):
+        if not isinstance(value, Mapping):
+            raise TypeError(f"{hint.__name__} expects an object, got {type(value).__name__}")
+        return from_dict(hint, value)
+    if hint in _PARSERS and isinstance(value, str):
+        try:
+            return _PARSERS[hint](value)
+        except ValueError:
+            return value
+    if isinstance(hint, type) and hint not in _JSON_TYPES and isinstance(value, _JSON_TYPES):
+        # Format types (UUID, IPv4Address, Decimal, ...) are built from their JSON value;
+        # floats are passed as strings so Decimal keeps the written digits
+        try:
+            return hint(str(value) if isinstance(value, float) else value)
+        except (TypeError, ValueError, ArithmeticError):
+            return value
+    return value
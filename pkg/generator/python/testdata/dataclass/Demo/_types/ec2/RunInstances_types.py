@@ -0,0 +1,72 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from dataclasses import dataclass, field
+from typing import Mapping
+from .._dataclass import from_dict as _from_dict, to_dict as _to_dict
+from .common_types import *  # Import service common types
+
+# Type definition for return value of RunInstances
+# From: pkg/generator/testdata/flows/Demo/ec2/RunInstances.json
+@dataclass
+class RunInstances_Result_Type:
+    Instances: Optional[List[RunInstances_Result_Instances_Item_Type]] = None
+    ReservationId: Optional[str] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> RunInstances_Result_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+@dataclass
+class RunInstances_Result_Instances_Item_Type:
+    InstanceId: Optional[str] = None
+    LaunchTime: Optional[datetime] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> RunInstances_Result_Instances_Item_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+# Type definition for parameter TagSpecification in RunInstances
+# From: pkg/generator/testdata/flows/Demo/ec2/RunInstances.json
+@dataclass
+class RunInstances_TagSpecification_Type:
+    ResourceType: str
+    Tags: Optional[List[Tag]] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> RunInstances_TagSpecification_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+@dataclass
+class Tag:
+    Key: Optional[str] = None
+    Value: Optional[str] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Tag:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
@@ -0,0 +1,61 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from dataclasses import dataclass, field
+from typing import Mapping
+from .._dataclass import from_dict as _from_dict, to_dict as _to_dict
+from .common_types import *  # Import service common types
+
+# Type definition for return value of Probe
+# From: pkg/generator/testdata/flows/Demo/net/Probe.json
+@dataclass
+class Probe_Result_Type:
+    addr: Optional[IPv4Address] = None
+    day: Optional[date] = None
+    id: Optional[UUID] = None
+    latency: Optional[float] = None
+    time_: Optional[time] = field(default=None, metadata={"name": "time"})
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Probe_Result_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+# Type definition for parameter host in Probe
+# From: pkg/generator/testdata/flows/Demo/net/Probe.json
+@dataclass
+class Probe_host_Type:
+    id: UUID
+    addr: Optional[IPv4Address] = None
+    addr6: Optional[IPv6Address] = None
+    big: Optional[int] = None
+    bigstr: Optional[str] = None
+    blob: Optional[bytes] = None
+    d: Optional[float] = None
+    day: Optional[date] = None
+    f: Optional[float] = None
+    mail: Optional[str] = None
+    price: Optional[float] = None
+    ratio: Optional[float] = None
+    seen: Optional[datetime] = None
+    site: Optional[str] = None
+    small: Optional[int] = None
+    time_: Optional[time] = field(default=None, metadata={"name": "time"})
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Probe_host_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
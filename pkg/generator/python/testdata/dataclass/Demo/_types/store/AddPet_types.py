@@ -0,0 +1,96 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from dataclasses import dataclass, field
+from typing import Mapping
+from .._dataclass import from_dict as _from_dict, to_dict as _to_dict
+from .common_types import *  # Import service common types
+
+@dataclass
+class Kitten:
+    kind: Optional[Literal["c"]] = None
+    lives: Optional[int] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Kitten:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+@dataclass
+class Puppy:
+    bark: Optional[bool] = None
+    kind: Optional[Literal["d"]] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Puppy:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+@dataclass
+class Base:
+    name: str
+    kind: Optional[str] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Base:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+@dataclass
+class Cat:
+    """A cat"""
+
+    kind: Literal["Cat"]
+    name: str
+    lives: Optional[int] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Cat:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+@dataclass
+class Dog:
+    name: str
+    bark: Optional[Union[str, bool, List[Union[int, datetime]]]] = None
+    kind: Optional[Literal["Dog"]] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Dog:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+Alias = Base
+
+# Type definition for return value of AddPet
+# From: pkg/generator/testdata/flows/Demo/store/AddPet.json
+AddPet_Result_Type = Union[Kitten, Puppy]
+
+# Type definition for parameter pet in AddPet
+# From: pkg/generator/testdata/flows/Demo/store/AddPet.json
+AddPet_pet_Type = Union[Cat, Dog]
+
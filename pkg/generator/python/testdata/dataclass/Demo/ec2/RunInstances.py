@@ -0,0 +1,46 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/ec2/RunInstances flow on the Pliant server.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+
+from .._runtime import PliantClient, execute as _execute
+from .._types._dataclass import from_value as _from_value
+
+# Import service-specific common types
+from .._types.ec2.common_types import *
+
+# Import operation-specific types
+from .._types.ec2.RunInstances_types import *
+
+
+def RunInstances(
+    ImageId: str,
+    *,
+    MaxCount: int = 1,
+    InstanceType: Optional[Literal["t2.micro", "t3.large"]] = None,
+    StartAt: Optional[datetime] = None,
+    Price: Optional[float] = None,
+    TagSpecification: Optional[RunInstances_TagSpecification_Type] = None,
+    client: Optional[PliantClient] = None,
+) -> RunInstances_Result_Type:
+    """Launches EC2 instances"""
+    inputs: Dict[str, Any] = {
+        "ImageId": ImageId,
+    }
+    if MaxCount is not None:
+        inputs["MaxCount"] = MaxCount
+    if InstanceType is not None:
+        inputs["InstanceType"] = InstanceType
+    if StartAt is not None:
+        inputs["StartAt"] = StartAt
+    if Price is not None:
+        inputs["Price"] = Price
+    if TagSpecification is not None:
+        inputs["TagSpecification"] = TagSpecification
+    result = _execute("Demo/ec2/RunInstances", inputs, output="result", client=client)
+    return _from_value(RunInstances_Result_Type, result)
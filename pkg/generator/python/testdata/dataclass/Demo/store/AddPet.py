@@ -0,0 +1,34 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/store/AddPet flow on the Pliant server.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+
+from .._runtime import PliantClient, execute as _execute
+from .._types._dataclass import from_value as _from_value
+
+# Import service-specific common types
+from .._types.store.common_types import *
+
+# Import operation-specific types
+from .._types.store.AddPet_types import *
+
+
+def AddPet(
+    pet: AddPet_pet_Type,
+    *,
+    tags: Optional[Any] = None,
+    client: Optional[PliantClient] = None,
+) -> AddPet_Result_Type:
+    """Adds a pet"""
+    inputs: Dict[str, Any] = {
+        "pet": pet,
+    }
+    if tags is not None:
+        inputs["tags"] = tags
+    result = _execute("Demo/store/AddPet", inputs, output="result", client=client)
+    return _from_value(AddPet_Result_Type, result)
@@ -0,0 +1,168 @@
+# Generated by LowCodeFusion
+"""Runtime of the Demo SDK: client settings and the HTTP transport
+to the Pliant flow execution API.
+
+Every operation runs its flow through a PliantClient. Configure the default
+client once, or pass client= to a single call:
+
+    import Demo
+    Demo.configure(base_url="https://pliant.example.com", token="...")
+"""
+import base64
+import json
+import os
+import urllib.error
+import urllib.parse
+import urllib.request
+from datetime import date, datetime, time
+from typing import Any, Dict, Mapping, Optional
+
+SDK_NAME = "Demo"
+SDK_VERSION = "1.0.0"
+
+# Environment variables the client settings default to
+ENV_BASE_URL = "PLIANT_BASE_URL"
+ENV_TOKEN = "PLIANT_TOKEN"
+ENV_API_KEY = "PLIANT_API_KEY"
+
+# Flows are executed with POST <base_url><execute_path>, {flow} is the flow path
+DEFAULT_EXECUTE_PATH = "/api/v1/trigger/{flow}"
+DEFAULT_API_KEY_HEADER = "X-API-Key"
+DEFAULT_TIMEOUT = 60.0
+
+
+class PliantError(Exception):
+    """Raised when a flow cannot be executed or the server rejects it."""
+
+    def __init__(self, message: str, status: Optional[int] = None, body: Optional[str] = None):
+        super().__init__(message)
+        self.status = status  # HTTP status, None if the server was not reached
+        self.body = body  # response body of a rejected request
+
+
+class PliantClient:
+    """Connection settings for the Pliant flow execution API.
+
+    Settings that are not given fall back to the PLIANT_BASE_URL, PLIANT_TOKEN
+    and PLIANT_API_KEY environment variables. The token is sent as a bearer
+    token, the API key in the api_key_header header.
+    """
+
+    def __init__(
+        self,
+        base_url: Optional[str] = None,
+        token: Optional[str] = None,
+        api_key: Optional[str] = None,
+        api_key_header: str = DEFAULT_API_KEY_HEADER,
+        execute_path: str = DEFAULT_EXECUTE_PATH,
+        timeout: float = DEFAULT_TIMEOUT,
+        headers: Optional[Mapping[str, str]] = None,
+    ):
+        base_url = base_url or os.environ.get(ENV_BASE_URL)
+        if not base_url:
+            raise PliantError(f"no Pliant server configured: pass base_url or set {ENV_BASE_URL}")
+        self.base_url = base_url.rstrip("/")
+        self.token = token or os.environ.get(ENV_TOKEN)
+        self.api_key = api_key or os.environ.get(ENV_API_KEY)
+        self.api_key_header = api_key_header
+        self.execute_path = execute_path
+        self.timeout = timeout
+        self.headers = dict(headers or {})
+
+    def execute(self, flow: str, inputs: Mapping[str, Any]) -> Any:
+        """Runs a flow with the given inputs and returns its decoded JSON output."""
+        request = urllib.request.Request(
+            self.flow_url(flow),
+            data=encode_inputs(inputs),
+            headers=self.request_headers(),
+            method="POST",
+        )
+
+        try:
+            with urllib.request.urlopen(request, timeout=self.timeout) as response:
+                payload = response.read()
+        except urllib.error.HTTPError as err:
+            detail = err.read().decode("utf-8", "replace")
+            raise PliantError(f"flow {flow} failed: HTTP {err.code} {err.reason}", err.code, detail) from err
+        except urllib.error.URLError as err:
+            raise PliantError(f"flow {flow} failed: {err.reason}") from err
+
+        return decode_output(flow, payload)
+
+    def flow_url(self, flow: str) -> str:
+        """Returns the URL a flow is executed at."""
+        return self.base_url + self.execute_path.replace("{flow}", urllib.parse.quote(flow))
+
+    def request_headers(self) -> Dict[str, str]:
+        """Returns the headers of a flow execution request."""
+        headers = {
+            "Accept": "application/json",
+            "Content-Type": "application/json",
+            "User-Agent": f"lcf-python/{SDK_NAME}/{SDK_VERSION}",
+        }
+        if self.token:
+            headers["Authorization"] = f"Bearer {self.token}"
+        if self.api_key:
+            headers[self.api_key_header] = self.api_key
+        headers.update(self.headers)
+        return headers
+
+
+_default_client: Optional[PliantClient] = None
+
+
+def configure(**settings: Any) -> PliantClient:
+    """Replaces the default client; takes the arguments of PliantClient."""
+    global _default_client
+    _default_client = PliantClient(**settings)
+    return _default_client
+
+
+def default_client() -> PliantClient:
+    """Returns the default client, created from the environment on first use."""
+    global _default_client
+    if _default_client is None:
+        _default_client = PliantClient()
+    return _default_client
+
+
+def execute(flow: str, inputs: Mapping[str, Any], output: Optional[str] = None, client: Optional[PliantClient] = None) -> Any:
+    """Runs a flow and returns the named output variable, or the whole output if the flow returns no such variable."""
+    return select_output((client or default_client()).execute(flow, inputs), output)
+
+
+def select_output(result: Any, output: Optional[str]) -> Any:
+    """Returns the named output variable of a flow result, or the whole result if it has no such variable."""
+    if output is not None and isinstance(result, dict) and output in result:
+        return result[output]
+    return result
+
+
+def encode_inputs(inputs: Mapping[str, Any]) -> bytes:
+    """Encodes flow inputs as a JSON request body."""
+    return json.dumps(inputs, default=_encode).encode("utf-8")
+
+
+def decode_output(flow: str, payload: bytes) -> Any:
+    """Decodes the JSON output of a flow; an empty response is None."""
+    if not payload.strip():
+        return None
+    try:
+        return json.loads(payload)
+    except ValueError as err:
+        raise PliantError(f"flow {flow} returned invalid JSON: {err}") from err
+
+
+def _encode(value: Any) -> Any:
+    """Serializes the values json cannot, such as datetime inputs, bytes (as base64), Pydantic models
+    and dataclasses. Other values, such as UUIDs, IP addresses or decimals of custom format types,
+    are sent as strings."""
+    if isinstance(value, (datetime, date, time)):
+        return value.isoformat()
+    if isinstance(value, (bytes, bytearray)):
+        return base64.b64encode(value).decode("ascii")
+    if hasattr(value, "model_dump"):
+        return value.model_dump(mode="json", by_alias=True, exclude_none=True)
+    if hasattr(value, "to_dict"):
+        return value.to_dict()
+    return str(value)
@@ -0,0 +1,91 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from decimal import Decimal
+from ipaddress import IPv4Address, IPv6Address
+from dataclasses import dataclass, field
+from typing import Mapping
+from .._dataclass import from_dict as _from_dict, to_dict as _to_dict
+from .common_types import *  # Import service common types
+
+@dataclass
+class Put_Result_Value_Type:
+    v: Optional[int] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Put_Result_Value_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+# Type definition for parameter config in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+@dataclass
+class Put_config_Type:
+    name: str
+    additional_properties: Dict[str, Setting] = field(default_factory=dict, metadata={"additional": True})
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Put_config_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+@dataclass
+class Setting:
+    v: Optional[int] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Setting:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+# Type definition for parameter nested in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+@dataclass
+class Put_nested_Type:
+    m: Optional[Dict[str, List[str]]] = None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Returns the object as a dict keyed by property names."""
+        return _to_dict(self)
+
+    @classmethod
+    def from_dict(cls, data: Mapping[str, Any]) -> Put_nested_Type:
+        """Creates the object from a dict keyed by property names."""
+        return _from_dict(cls, data)
+
+Tree = Dict[str, Any]
+
+# Type definition for return value of Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_Result_Type = Dict[str, Put_Result_Value_Type]
+
+# Type definition for parameter anything in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_anything_Type = Dict[str, Any]
+
+# Type definition for parameter counts in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_counts_Type = Dict[str, Union[int, str]]
+
+# Type definition for parameter labels in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_labels_Type = Dict[str, str]
+
+# Type definition for parameter tree in Put
+# From: pkg/generator/testdata/flows/Demo/store/Put.json
+Put_tree_Type = Tree
+
@@ -0,0 +1,34 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/net/Probe flow on the Pliant server.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+from datetime import date, datetime, time
+from decimal import Decimal
+from ipaddress import IPv4Address, IPv6Address
+
+from .._runtime import PliantClient, execute as _execute
+from .._types._dataclass import from_value as _from_value
+
+# Import service-specific common types
+from .._types.net.common_types import *
+
+# Import operation-specific types
+from .._types.net.Probe_types import *
+
+
+def Probe(
+    host: Probe_host_Type,
+    *,
+    threshold: float = 0.5,
+    client: Optional[PliantClient] = None,
+) -> Probe_Result_Type:
+    """Probes a host"""
+    inputs: Dict[str, Any] = {
+        "host": host,
+    }
+    if threshold is not None:
+        inputs["threshold"] = threshold
+    result = _execute("Demo/net/Probe", inputs, output="result", client=client)
+    return _from_value(Probe_Result_Type, result)
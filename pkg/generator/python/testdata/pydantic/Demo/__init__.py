@@ -0,0 +1,2 @@
+# Generated by LowCodeFusion
+from ._runtime import PliantClient, PliantError, configure, default_client
@@ -0,0 +1,14 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from pydantic import BaseModel, ConfigDict, Field
+from .common_types import *  # Import service common types
+
+# Type definition for parameter tags in Clash
+# From: pkg/generator/testdata/flows/Demo/Clash.json
+Clash_tags_Type = List[str]
+
@@ -0,0 +1,34 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from pydantic import BaseModel, ConfigDict, Field
+from .common_types import *  # Import service common types
+
+# Type definition for return value of RunInstances
+# From: pkg/generator/testdata/flows/Demo/ec2/RunInstances.json
+class RunInstances_Result_Type(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    Instances: Optional[List[RunInstances_Result_Instances_Item_Type]] = None
+    ReservationId: Optional[str] = None
+
+class RunInstances_Result_Instances_Item_Type(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    InstanceId: Optional[str] = None
+    LaunchTime: Optional[datetime] = None
+
+# Type definition for parameter TagSpecification in RunInstances
+# From: pkg/generator/testdata/flows/Demo/ec2/RunInstances.json
+class RunInstances_TagSpecification_Type(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    ResourceType: str
+    Tags: Optional[List[Tag]] = None
+
+class Tag(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    Key: Optional[str] = None
+    Value: Optional[str] = None
+
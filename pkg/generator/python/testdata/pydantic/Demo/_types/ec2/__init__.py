@@ -0,0 +1 @@
+# Generated by LowCodeFusion
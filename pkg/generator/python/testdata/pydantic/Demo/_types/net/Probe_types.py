@@ -0,0 +1,41 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from pydantic import BaseModel, ConfigDict, Field
+from .common_types import *  # Import service common types
+
+# Type definition for return value of Probe
+# From: pkg/generator/testdata/flows/Demo/net/Probe.json
+class Probe_Result_Type(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    addr: Optional[IPv4Address] = None
+    day: Optional[date] = None
+    id: Optional[UUID] = None
+    latency: Optional[float] = None
+    time_: Optional[time] = Field(default=None, alias="time")
+
+# Type definition for parameter host in Probe
+# From: pkg/generator/testdata/flows/Demo/net/Probe.json
+class Probe_host_Type(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=(), ser_json_bytes="base64", val_json_bytes="base64")
+    addr: Optional[IPv4Address] = None
+    addr6: Optional[IPv6Address] = None
+    big: Optional[int] = None
+    bigstr: Optional[str] = None
+    blob: Optional[bytes] = None
+    d: Optional[float] = None
+    day: Optional[date] = None
+    f: Optional[float] = None
+    id: UUID
+    mail: Optional[str] = None
+    price: Optional[float] = None
+    ratio: Optional[float] = None
+    seen: Optional[datetime] = None
+    site: Optional[str] = None
+    small: Optional[int] = None
+    time_: Optional[time] = Field(default=None, alias="time")
+
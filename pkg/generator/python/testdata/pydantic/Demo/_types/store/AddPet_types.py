@@ -0,0 +1,49 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from datetime import date, datetime, time
+from ipaddress import IPv4Address, IPv6Address
+from uuid import UUID
+from pydantic import BaseModel, ConfigDict, Field
+from .common_types import *  # Import service common types
+
+class Kitten(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    kind: Optional[Literal["c"]] = None
+    lives: Optional[int] = None
+
+class Puppy(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    bark: Optional[bool] = None
+    kind: Optional[Literal["d"]] = None
+
+class Base(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    kind: Optional[str] = None
+    name: str
+
+class Cat(BaseModel):
+    """A cat"""
+
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    kind: Literal["Cat"]
+    lives: Optional[int] = None
+    name: str
+
+class Dog(BaseModel):
+    model_config = ConfigDict(populate_by_name=True, protected_namespaces=())
+    bark: Optional[Union[str, bool, List[Union[int, datetime]]]] = None
+    kind: Optional[Literal["Dog"]] = None
+    name: str
+
+Alias = Base
+
+# Type definition for return value of AddPet
+# From: pkg/generator/testdata/flows/Demo/store/AddPet.json
+AddPet_Result_Type = Union[Kitten, Puppy]
+
+# Type definition for parameter pet in AddPet
+# From: pkg/generator/testdata/flows/Demo/store/AddPet.json
+AddPet_pet_Type = Union[Cat, Dog]
+
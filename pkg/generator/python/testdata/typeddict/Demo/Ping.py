@@ -0,0 +1,23 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/Ping flow on the Pliant server.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+
+from ._runtime import PliantClient, execute as _execute
+
+# Import service-specific common types
+from ._types.Demo.common_types import *
+
+# Import operation-specific types
+from ._types.Demo.Ping_types import *
+
+
+def Ping(
+    *,
+    client: Optional[PliantClient] = None,
+) -> None:
+    """Checks that the integration is reachable"""
+    inputs: Dict[str, Any] = {}
+    _execute("Demo/Ping", inputs, output=None, client=client)
@@ -0,0 +1,26 @@
+# Generated by LowCodeFusion
+from __future__ import annotations  # types may refer to types defined later
+
+from typing import Any, Dict, List, Optional, Union, TypedDict, Literal
+from .common_types import *  # Import service common types
+
+# Type definition for return value of RunInstances
+# From: pkg/generator/testdata/flows/Demo/ec2/RunInstances.json
+class RunInstances_Result_Type(TypedDict, total=False):
+    Instances: Optional[List[RunInstances_Result_Instances_Item_Type]]
+    ReservationId: Optional[str]
+
+class RunInstances_Result_Instances_Item_Type(TypedDict, total=False):
+    InstanceId: Optional[str]
+    LaunchTime: Optional[str]
+
+# Type definition for parameter TagSpecification in RunInstances
+# From: pkg/generator/testdata/flows/Demo/ec2/RunInstances.json
+class RunInstances_TagSpecification_Type(TypedDict, total=False):
+    ResourceType: str
+    Tags: Optional[List[Tag]]
+
+class Tag(TypedDict, total=False):
+    Key: Optional[str]
+    Value: Optional[str]
+
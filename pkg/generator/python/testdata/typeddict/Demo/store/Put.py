@@ -0,0 +1,40 @@
+'''Auto-generated Python client for Pliant integration: Demo
+   Runs the Demo/store/Put flow on the Pliant server.
+'''
+from __future__ import annotations
+
+from typing import Any, Dict, List, Literal, Optional, Union, TypedDict
+
+from .._runtime import PliantClient, execute as _execute
+
+# Import service-specific common types
+from .._types.store.common_types import *
+
+# Import operation-specific types
+from .._types.store.Put_types import *
+
+
+def Put(
+    labels: Put_labels_Type,
+    config: Put_config_Type,
+    *,
+    counts: Optional[Put_counts_Type] = None,
+    tree: Optional[Put_tree_Type] = None,
+    nested: Optional[Put_nested_Type] = None,
+    anything: Optional[Put_anything_Type] = None,
+    client: Optional[PliantClient] = None,
+) -> Put_Result_Type:
+    """Stores settings"""
+    inputs: Dict[str, Any] = {
+        "labels": labels,
+        "config": config,
+    }
+    if counts is not None:
+        inputs["counts"] = counts
+    if tree is not None:
+        inputs["tree"] = tree
+    if nested is not None:
+        inputs["nested"] = nested
+    if anything is not None:
+        inputs["anything"] = anything
+    return _execute("Demo/store/Put", inputs, output="result", client=client)
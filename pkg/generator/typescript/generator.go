@@ -0,0 +1,658 @@
+// File: pkg/generator/typescript/generator.go
+
+package typescript
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Operation is the template view of an operation module
+type Operation struct {
+	Name       string      // function and module name (e.g. "RunInstances")
+	Doc        []string    // lines of the doc comment
+	Params     []Parameter // required parameters first
+	ReturnType string      // "void" if the flow has no output
+}
+
+// Parameter is a function parameter
+type Parameter struct {
+	Name     string
+	Type     string
+	Required bool
+}
+
+// Import is an import of types from another module
+type Import struct {
+	Names []string
+	From  string
+}
+
+// Options are the TypeScript-specific generator settings
+type Options struct {
+	PackageName string                // npm package name, derived from the integration if empty
+	Formats     generator.FormatTable // JSON Schema format -> TypeScript type, DefaultFormats if nil
+}
+
+// DefaultFormats maps JSON Schema formats to TypeScript types. Results are the parsed
+// JSON, so every format keeps the type JSON.parse returns; overrides can name type
+// aliases such as branded strings, imported from a module.
+var DefaultFormats = generator.FormatTable{
+	"date-time": {Type: "string", JSONType: "string"},
+	"date":      {Type: "string", JSONType: "string"},
+	"time":      {Type: "string", JSONType: "string"},
+	"uuid":      {Type: "string", JSONType: "string"},
+	"uri":       {Type: "string", JSONType: "string"},
+	"email":     {Type: "string", JSONType: "string"},
+	"ipv4":      {Type: "string", JSONType: "string"},
+	"ipv6":      {Type: "string", JSONType: "string"},
+	"byte":      {Type: "string", JSONType: "string"}, // base64
+	"binary":    {Type: "string", JSONType: "string"},
+	"int32":     {Type: "number", JSONType: "integer"},
+	"int64":     {Type: "number", JSONType: "integer"}, // beyond 2^53 JSON.parse loses precision
+	"float":     {Type: "number", JSONType: "number"},
+	"double":    {Type: "number", JSONType: "number"},
+}
+
+// identifierRe matches names usable as identifiers and unquoted property names
+var identifierRe = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// reservedWords cannot be used as parameter or function names
+var reservedWords = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true, "continue": true,
+	"debugger": true, "default": true, "delete": true, "do": true, "else": true, "enum": true,
+	"export": true, "extends": true, "false": true, "finally": true, "for": true, "function": true,
+	"if": true, "import": true, "in": true, "instanceof": true, "new": true, "null": true,
+	"return": true, "super": true, "switch": true, "this": true, "throw": true, "true": true,
+	"try": true, "typeof": true, "var": true, "void": true, "while": true, "with": true,
+	"implements": true, "interface": true, "let": true, "package": true, "private": true,
+	"protected": true, "public": true, "static": true, "yield": true, "await": true,
+}
+
+// Generate writes a TypeScript package for an integration:
+//
+//	<out>/<integration>/package.json, tsconfig.json, index.ts
+//	<out>/<integration>/_types/<service>/common_types.ts      types shared within a service
+//	<out>/<integration>/_types/<service>/<Operation>_types.ts types of one operation
+//	<out>/<integration>/<service>/<Operation>.ts              one module per operation
+//	<out>/<integration>/<service>/index.ts                    barrel of the service
+func Generate(integration *ir.Integration, outDir string, opts Options) error {
+	pkgDir := filepath.Join(outDir, integration.Name)
+	if err := os.MkdirAll(pkgDir, 0755); err != nil {
+		return fmt.Errorf("failed to create package directory %s: %v", pkgDir, err)
+	}
+	fmt.Printf("Package directory: %s\n", pkgDir)
+
+	formats := opts.Formats
+	if formats == nil {
+		formats = DefaultFormats
+	}
+
+	tmpl, err := loadTemplate()
+	if err != nil {
+		return err
+	}
+
+	// Modules of each directory, used for the barrel files
+	barrels := newBarrels()
+
+	fmt.Println("Generating TypeScript modules:")
+	count := 0
+	for _, service := range integration.Services {
+		model := generator.NewServiceModel(service)
+
+		// Write the types of the service
+		typesDir := path.Join("_types", service.Name)
+		if err := writeTypesFile(pkgDir, path.Join(typesDir, "common_types.ts"), model.Common, nil, formats); err != nil {
+			return err
+		}
+		barrels.addTypes(service.Name, path.Join(typesDir, "common_types"))
+
+		for _, op := range model.Operations {
+			name := identifier(op.Operation.Name)
+			opTypes := path.Join(typesDir, name+"_types")
+			if err := writeTypesFile(pkgDir, opTypes+".ts", op.Decls, model.Common, formats); err != nil {
+				return err
+			}
+			barrels.addTypes(service.Name, opTypes)
+
+			// Write the operation module
+			opDir := path.Join(op.Operation.Path...)
+			modulePath := path.Join(opDir, name)
+			if err := writeOperation(tmpl, pkgDir, modulePath, integration.Name, op, model.Common, opTypes, formats); err != nil {
+				return err
+			}
+			barrels.addModule(opDir, name)
+			count++
+
+			fmt.Printf("  - Generated: %s\n", filepath.Join(pkgDir, filepath.FromSlash(modulePath)+".ts"))
+		}
+	}
+
+	// Write the barrel files and the package manifests
+	if err := barrels.write(pkgDir); err != nil {
+		return err
+	}
+	if err := writePackageFiles(pkgDir, integration, opts); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nSuccessfully generated %d TypeScript modules\n", count)
+	return nil
+}
+
+// loadTemplate reads the operation module template
+func loadTemplate() (*template.Template, error) {
+	tmplPath := "templates/typescript_func.tmpl"
+	tmplContent, err := os.ReadFile(tmplPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file %s: %v", tmplPath, err)
+	}
+
+	tmpl, err := template.New("typescript_func").Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(string(tmplContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// writeOperation renders the module of one operation
+func writeOperation(
+	tmpl *template.Template,
+	pkgDir string,
+	modulePath string,
+	integrationName string,
+	op *generator.OperationModel,
+	common []generator.Decl,
+	opTypes string,
+	formats generator.FormatTable,
+) error {
+	types := newTypeMapper(formats)
+	view := Operation{
+		Name:       identifier(op.Operation.Name),
+		Doc:        docLines(op.Operation.Description),
+		ReturnType: "void",
+	}
+
+	// Required parameters first, optional ones can then be left out
+	var used []ir.Schema
+	var optional []Parameter
+	for _, input := range op.Inputs {
+		param := Parameter{
+			Name:     identifier(input.Name),
+			Type:     types.tsType(input.Schema),
+			Required: input.Required,
+		}
+		if input.Description != "" {
+			view.Doc = append(view.Doc, fmt.Sprintf("@param %s %s", param.Name, firstLine(input.Description)))
+		}
+		if param.Required {
+			view.Params = append(view.Params, param)
+		} else {
+			optional = append(optional, param)
+		}
+		used = append(used, input.Schema)
+	}
+	view.Params = append(view.Params, optional...)
+	if op.Output != nil {
+		view.ReturnType = types.tsType(op.Output.Schema)
+		used = append(used, op.Output.Schema)
+	}
+
+	// Import the declarations the signature refers to
+	data := struct {
+		Integration string
+		Op          Operation
+		Imports     []Import
+	}{
+		Integration: integrationName,
+		Op:          view,
+		Imports:     append(types.formatImports(path.Dir(modulePath)), imports(path.Dir(modulePath), used, common, opTypes)...),
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return fmt.Errorf("failed to execute template: %v", err)
+	}
+	return writeFile(pkgDir, modulePath+".ts", buffer.Bytes())
+}
+
+// imports groups the declarations used by schemas by the types file defining them
+func imports(fromDir string, schemas []ir.Schema, common []generator.Decl, opTypes string) []Import {
+	commonNames := make(map[string]bool)
+	for _, decl := range common {
+		commonNames[decl.Name] = true
+	}
+	commonTypes := path.Join(path.Dir(opTypes), "common_types")
+
+	byFile := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, schema := range schemas {
+		for _, name := range generator.DeclRefs(schema) {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			file := opTypes
+			if commonNames[name] {
+				file = commonTypes
+			}
+			byFile[file] = append(byFile[file], name)
+		}
+	}
+
+	var result []Import
+	for _, file := range []string{commonTypes, opTypes} {
+		if names := byFile[file]; len(names) > 0 {
+			sort.Strings(names)
+			result = append(result, Import{Names: names, From: relativeModule(fromDir, file)})
+		}
+	}
+	return result
+}
+
+// writeTypesFile writes exported interfaces and type aliases for declarations
+func writeTypesFile(pkgDir, filePath string, decls []generator.Decl, common []generator.Decl, formats generator.FormatTable) error {
+	types := newTypeMapper(formats)
+	var body string
+	for _, decl := range decls {
+		body += "\n"
+		body += docComment(decl.Description, decl.Source, "")
+		body += types.tsDecl(decl)
+	}
+
+	content := "// Generated by LowCodeFusion\n"
+	for _, imp := range types.formatImports(path.Dir(filePath)) {
+		content += fmt.Sprintf("import type { %s } from \"%s\";\n", strings.Join(imp.Names, ", "), imp.From)
+	}
+
+	// Operation types import the common types of their service they refer to
+	if len(common) > 0 {
+		var schemas []ir.Schema
+		for _, decl := range decls {
+			schemas = append(schemas, decl.Schema)
+		}
+		for _, imp := range imports(path.Dir(filePath), schemas, common, strings.TrimSuffix(filePath, ".ts")) {
+			if strings.HasSuffix(imp.From, "/common_types") {
+				content += fmt.Sprintf("import type { %s } from \"%s\";\n", strings.Join(imp.Names, ", "), imp.From)
+			}
+		}
+	}
+	if len(decls) == 0 {
+		content += "export {};\n"
+	}
+
+	return writeFile(pkgDir, filePath, []byte(content+body))
+}
+
+// typeMapper converts schemas to TypeScript types and remembers the format types they import
+type typeMapper struct {
+	formats generator.FormatTable
+	imports map[string]map[string]bool // module -> imported type names
+}
+
+// newTypeMapper creates a typeMapper without imports
+func newTypeMapper(formats generator.FormatTable) *typeMapper {
+	return &typeMapper{formats: formats, imports: make(map[string]map[string]bool)}
+}
+
+// formatImports lists the imports of the format types used, by module. Modules starting
+// with "./" are relative to the package root and rewritten for the importing directory.
+func (m *typeMapper) formatImports(fromDir string) []Import {
+	modules := make([]string, 0, len(m.imports))
+	for module := range m.imports {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	var result []Import
+	for _, module := range modules {
+		names := make([]string, 0, len(m.imports[module]))
+		for name := range m.imports[module] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		from := module
+		if strings.HasPrefix(module, "./") {
+			from = relativeModule(fromDir, path.Clean(module))
+		}
+		result = append(result, Import{Names: names, From: from})
+	}
+	return result
+}
+
+// tsDecl renders a declaration
+func (m *typeMapper) tsDecl(decl generator.Decl) string {
+	switch decl.Kind {
+	case generator.StructDecl:
+		result := fmt.Sprintf("export interface %s {\n", decl.Name)
+		for _, propName := range decl.Schema.PropertyNames() {
+			prop := decl.Schema.Properties[propName]
+			optional := "?"
+			if decl.Schema.IsRequired(propName) {
+				optional = ""
+			}
+			result += docComment(prop.Description, "", "  ")
+			result += fmt.Sprintf("  %s%s: %s;\n", propertyName(propName), optional, m.tsType(prop))
+		}
+		return result + "}\n"
+	case generator.EnumDecl:
+		return fmt.Sprintf("export type %s = %s;\n", decl.Name, enumUnion(decl.Schema))
+	default:
+		return fmt.Sprintf("export type %s = %s;\n", decl.Name, m.tsType(decl.Schema))
+	}
+}
+
+// tsType converts a flattened schema to a TypeScript type
+func (m *typeMapper) tsType(schema ir.Schema) string {
+	if name, ok := generator.DeclName(schema); ok {
+		return name
+	}
+	if len(schema.Enum) > 0 {
+		return enumUnion(schema)
+	}
+	if ft, ok := m.formats.Lookup(schema); ok {
+		if ft.Import != "" {
+			if m.imports[ft.Import] == nil {
+				m.imports[ft.Import] = make(map[string]bool)
+			}
+			m.imports[ft.Import][ft.Type] = true
+		}
+		return ft.Type
+	}
+
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		if schema.Items != nil {
+			return fmt.Sprintf("Array<%s>", m.tsType(*schema.Items))
+		}
+		return "Array<unknown>"
+	case "object", "map":
+		if schema.AdditionalProperties != nil {
+			return fmt.Sprintf("Record<string, %s>", m.tsType(*schema.AdditionalProperties))
+		}
+		return "Record<string, unknown>"
+	}
+
+	// For oneOf, build a union type
+	if len(schema.OneOf) > 0 {
+		types := make([]string, 0, len(schema.OneOf))
+		for _, variant := range schema.OneOf {
+			types = append(types, m.tsType(variant))
+		}
+		return strings.Join(types, " | ")
+	}
+	return "unknown"
+}
+
+// enumUnion renders enum values as a union of literal types
+func enumUnion(schema ir.Schema) string {
+	values := make([]string, 0, len(schema.Enum))
+	for _, val := range schema.Enum {
+		switch schema.Type {
+		case "integer", "number", "boolean":
+			values = append(values, val)
+		default:
+			values = append(values, fmt.Sprintf("%q", val))
+		}
+	}
+	return strings.Join(values, " | ")
+}
+
+// docComment renders a JSDoc comment, or nothing if there is nothing to say
+func docComment(description, source, indent string) string {
+	lines := docLines(description)
+	if source != "" {
+		lines = append(lines, "From: "+source)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	if len(lines) == 1 {
+		return fmt.Sprintf("%s/** %s */\n", indent, lines[0])
+	}
+
+	result := indent + "/**\n"
+	for _, line := range lines {
+		result += strings.TrimRight(fmt.Sprintf("%s * %s", indent, line), " ") + "\n"
+	}
+	return result + indent + " */\n"
+}
+
+// docLines splits a description into comment lines that cannot end the comment
+func docLines(description string) []string {
+	description = strings.TrimSpace(strings.ReplaceAll(description, "*/", "*\\/"))
+	if description == "" {
+		return nil
+	}
+	return strings.Split(description, "\n")
+}
+
+// firstLine returns the first line of a description
+func firstLine(description string) string {
+	return strings.TrimSpace(strings.SplitN(description, "\n", 2)[0])
+}
+
+// identifier converts a name to a valid TypeScript identifier
+func identifier(name string) string {
+	re := regexp.MustCompile(`[^A-Za-z0-9_$]`)
+	name = re.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	if reservedWords[name] {
+		name += "_"
+	}
+	return name
+}
+
+// propertyName quotes property names that are not identifiers (e.g. "content-type")
+func propertyName(name string) string {
+	if identifierRe.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+// relativeModule returns the import specifier of a module from a directory
+func relativeModule(fromDir, module string) string {
+	rel, err := filepath.Rel(filepath.FromSlash(fromDir), filepath.FromSlash(module))
+	if err != nil {
+		return module
+	}
+	rel = filepath.ToSlash(rel)
+	if !strings.HasPrefix(rel, ".") {
+		rel = "./" + rel
+	}
+	return rel
+}
+
+// writeFile writes a file below the package directory
+func writeFile(pkgDir, relPath string, content []byte) error {
+	filePath := filepath.Join(pkgDir, filepath.FromSlash(relPath))
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", dir, err)
+	}
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write file %s: %v", filePath, err)
+	}
+	return nil
+}
+
+// barrels collects the exports of the index.ts file of each directory
+type barrels struct {
+	modules map[string][]string // directory -> operation modules
+	types   map[string][]string // service directory -> types modules
+}
+
+// newBarrels creates an empty set of barrel files
+func newBarrels() *barrels {
+	return &barrels{
+		modules: map[string][]string{"": nil},
+		types:   make(map[string][]string),
+	}
+}
+
+// addModule records an operation module and makes every parent directory a barrel
+func (b *barrels) addModule(dir, name string) {
+	b.modules[dir] = append(b.modules[dir], name)
+	for dir != "" && dir != "." {
+		dir = parentDir(dir)
+		if _, ok := b.modules[dir]; !ok {
+			b.modules[dir] = nil
+		}
+	}
+}
+
+// addTypes records a types module exported by the barrel of a service
+func (b *barrels) addTypes(serviceDir, module string) {
+	b.types[serviceDir] = append(b.types[serviceDir], module)
+}
+
+// write writes index.ts into every directory with modules
+func (b *barrels) write(pkgDir string) error {
+	for dir, modules := range b.modules {
+		content := "// Generated by LowCodeFusion\n"
+		for _, module := range b.types[dir] {
+			content += fmt.Sprintf("export * from \"%s\";\n", relativeModule(dir, module))
+		}
+
+		// Subdirectories become namespaces
+		var subdirs []string
+		for other := range b.modules {
+			if other != "" && other != dir && parentDir(other) == dir {
+				subdirs = append(subdirs, other)
+			}
+		}
+		sort.Strings(subdirs)
+		for _, sub := range subdirs {
+			content += fmt.Sprintf("export * as %s from \"./%s\";\n", identifier(path.Base(sub)), path.Base(sub))
+		}
+
+		sort.Strings(modules)
+		for _, module := range modules {
+			content += fmt.Sprintf("export * from \"./%s\";\n", module)
+		}
+
+		if err := writeFile(pkgDir, path.Join(dir, "index.ts"), []byte(content)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parentDir returns the parent of a slash-separated directory, "" for top-level directories
+func parentDir(dir string) string {
+	parent := path.Dir(dir)
+	if parent == "." {
+		return ""
+	}
+	return parent
+}
+
+// packageJSON is the npm manifest of the generated package
+type packageJSON struct {
+	Name            string            `json:"name"`
+	Version         string            `json:"version"`
+	Description     string            `json:"description"`
+	Main            string            `json:"main"`
+	Types           string            `json:"types"`
+	Files           []string          `json:"files"`
+	Scripts         map[string]string `json:"scripts"`
+	DevDependencies map[string]string `json:"devDependencies"`
+}
+
+// tsconfigJSON is the compiler configuration of the generated package
+type tsconfigJSON struct {
+	CompilerOptions compilerOptions `json:"compilerOptions"`
+	Include         []string        `json:"include"`
+	Exclude         []string        `json:"exclude"`
+}
+
+// compilerOptions are the TypeScript compiler settings
+type compilerOptions struct {
+	Target          string `json:"target"`
+	Module          string `json:"module"`
+	Declaration     bool   `json:"declaration"`
+	Strict          bool   `json:"strict"`
+	EsModuleInterop bool   `json:"esModuleInterop"`
+	OutDir          string `json:"outDir"`
+	RootDir         string `json:"rootDir"`
+}
+
+// writePackageFiles writes package.json and tsconfig.json
+func writePackageFiles(pkgDir string, integration *ir.Integration, opts Options) error {
+	name := opts.PackageName
+	if name == "" {
+		name = packageName(integration.Name)
+	}
+	version := integration.Version
+	if version == "" {
+		version = "0.0.0"
+	}
+
+	pkg := packageJSON{
+		Name:            name,
+		Version:         version,
+		Description:     fmt.Sprintf("Generated SDK for the Pliant %s integration", integration.Name),
+		Main:            "dist/index.js",
+		Types:           "dist/index.d.ts",
+		Files:           []string{"dist"},
+		Scripts:         map[string]string{"build": "tsc"},
+		DevDependencies: map[string]string{"typescript": "^5.0.0"},
+	}
+	tsconfig := tsconfigJSON{
+		CompilerOptions: compilerOptions{
+			Target:          "ES2020",
+			Module:          "commonjs",
+			Declaration:     true,
+			Strict:          true,
+			EsModuleInterop: true,
+			OutDir:          "dist",
+			RootDir:         ".",
+		},
+		Include: []string{"**/*.ts"},
+		Exclude: []string{"node_modules", "dist"},
+	}
+
+	for fileName, content := range map[string]interface{}{"package.json": pkg, "tsconfig.json": tsconfig} {
+		data, err := json.MarshalIndent(content, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %v", fileName, err)
+		}
+		if err := writeFile(pkgDir, fileName, append(data, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packageName derives an npm package name from the integration name (e.g. "Active Directory" -> "active-directory")
+func packageName(integrationName string) string {
+	re := regexp.MustCompile(`[^a-z0-9._-]+`)
+	name := strings.Trim(re.ReplaceAllString(strings.ToLower(integrationName), "-"), "-._")
+	if name == "" {
+		return "pliant-integration"
+	}
+	return name
+}
@@ -0,0 +1,21 @@
+// File: pkg/generator/typescript/generator_test.go
+
+package typescript
+
+import (
+	"testing"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/generator/generatortest"
+)
+
+func TestGenerateGolden(t *testing.T) {
+	formats := DefaultFormats.Clone()
+	if err := formats.Set("uuid=Uuid@./brands,date-time=Timestamp@@acme/time,int64=bigint"); err != nil {
+		t.Fatal(err)
+	}
+	generatortest.Run(t, map[string]generator.Generator{
+		"golden":  &Generator{},
+		"formats": &Generator{opts: Options{Formats: formats}},
+	})
+}
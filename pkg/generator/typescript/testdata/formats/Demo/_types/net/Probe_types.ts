@@ -0,0 +1,32 @@
+// Generated by LowCodeFusion
+import type { Uuid } from "../../brands";
+import type { Timestamp } from "@acme/time";
+
+/** From: Demo/net/Probe.json */
+export interface ProbeHost {
+  addr?: string;
+  addr6?: string;
+  big?: bigint;
+  bigstr?: string;
+  blob?: string;
+  d?: number;
+  day?: string;
+  f?: number;
+  id: Uuid;
+  mail?: string;
+  price?: number;
+  ratio?: number;
+  seen?: Timestamp;
+  site?: string;
+  small?: number;
+  time?: string;
+}
+
+/** From: Demo/net/Probe.json */
+export interface ProbeResult {
+  addr?: string;
+  day?: string;
+  id?: Uuid;
+  latency?: number;
+  time?: string;
+}
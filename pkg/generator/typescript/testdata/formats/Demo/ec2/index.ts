@@ -0,0 +1,4 @@
+// Generated by LowCodeFusion
+export * from "../_types/ec2/common_types";
+export * from "../_types/ec2/RunInstances_types";
+export * from "./RunInstances";
@@ -0,0 +1,11 @@
+// Auto-generated TypeScript stub for Pliant integration: Demo
+// Right now this function only logs its name.
+import type { AddPetPet, AddPetResult } from "../_types/store/AddPet_types";
+
+/**
+ * Adds a pet
+ */
+export function AddPet(pet: AddPetPet, tags?: unknown): AddPetResult {
+  console.log("Function name: AddPet");
+  return {} as AddPetResult;
+}
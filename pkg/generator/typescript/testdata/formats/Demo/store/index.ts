@@ -0,0 +1,6 @@
+// Generated by LowCodeFusion
+export * from "../_types/store/common_types";
+export * from "../_types/store/AddPet_types";
+export * from "../_types/store/Put_types";
+export * from "./AddPet";
+export * from "./Put";
@@ -0,0 +1,11 @@
+// Auto-generated TypeScript stub for Pliant integration: Demo
+// Right now this function only logs its name.
+import type { ClashTags } from "./_types/Clash_types";
+
+/**
+ * names
+ */
+export function Clash(inputs: string, count: number, result?: string, client?: string, tags?: ClashTags): string {
+  console.log("Function name: Clash");
+  return {} as string;
+}
@@ -0,0 +1,9 @@
+// Auto-generated TypeScript stub for Pliant integration: Demo
+// Right now this function only logs its name.
+
+/**
+ * Checks that the integration is reachable
+ */
+export function Ping(): void {
+  console.log("Function name: Ping");
+}
@@ -0,0 +1,4 @@
+// Generated by LowCodeFusion
+
+/** From: Demo/Clash.json */
+export type ClashTags = Array<string>;
@@ -0,0 +1,28 @@
+// Generated by LowCodeFusion
+
+/** From: Demo/ec2/RunInstances.json */
+export interface Tag {
+  Key?: string;
+  Value?: string;
+}
+
+/** From: Demo/ec2/RunInstances.json */
+export type RunInstancesInstanceType = "t2.micro" | "t3.large";
+
+/** From: Demo/ec2/RunInstances.json */
+export interface RunInstancesTagSpecification {
+  ResourceType: string;
+  Tags?: Array<Tag>;
+}
+
+/** From: Demo/ec2/RunInstances.json */
+export interface RunInstancesResult {
+  Instances?: Array<RunInstancesResultInstancesItem>;
+  ReservationId?: string;
+}
+
+/** From: Demo/ec2/RunInstances.json */
+export interface RunInstancesResultInstancesItem {
+  InstanceId?: string;
+  LaunchTime?: string;
+}
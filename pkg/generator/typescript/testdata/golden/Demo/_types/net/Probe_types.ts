@@ -0,0 +1,30 @@
+// Generated by LowCodeFusion
+
+/** From: Demo/net/Probe.json */
+export interface ProbeHost {
+  addr?: string;
+  addr6?: string;
+  big?: number;
+  bigstr?: string;
+  blob?: string;
+  d?: number;
+  day?: string;
+  f?: number;
+  id: string;
+  mail?: string;
+  price?: number;
+  ratio?: number;
+  seen?: string;
+  site?: string;
+  small?: number;
+  time?: string;
+}
+
+/** From: Demo/net/Probe.json */
+export interface ProbeResult {
+  addr?: string;
+  day?: string;
+  id?: string;
+  latency?: number;
+  time?: string;
+}
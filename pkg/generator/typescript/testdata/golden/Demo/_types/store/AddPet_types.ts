@@ -0,0 +1,60 @@
+// Generated by LowCodeFusion
+
+/**
+ * An alias
+ * From: Demo/store/AddPet.json
+ */
+export type Alias = Base;
+
+/** From: Demo/store/AddPet.json */
+export interface Base {
+  kind?: string;
+  name: string;
+}
+
+/**
+ * A cat
+ * From: Demo/store/AddPet.json
+ */
+export interface Cat {
+  kind: CatKind;
+  lives?: number;
+  name: string;
+}
+
+/** From: Demo/store/AddPet.json */
+export type CatKind = "Cat";
+
+/** From: Demo/store/AddPet.json */
+export interface Dog {
+  bark?: string | boolean | Array<number | string>;
+  kind?: DogKind;
+  name: string;
+}
+
+/** From: Demo/store/AddPet.json */
+export type DogKind = "Dog";
+
+/** From: Demo/store/AddPet.json */
+export interface Kitten {
+  kind?: KittenKind;
+  lives?: number;
+}
+
+/** From: Demo/store/AddPet.json */
+export type KittenKind = "c";
+
+/** From: Demo/store/AddPet.json */
+export interface Puppy {
+  bark?: boolean;
+  kind?: PuppyKind;
+}
+
+/** From: Demo/store/AddPet.json */
+export type PuppyKind = "d";
+
+/** From: Demo/store/AddPet.json */
+export type AddPetPet = Cat | Dog;
+
+/** From: Demo/store/AddPet.json */
+export type AddPetResult = Kitten | Puppy;
@@ -0,0 +1,36 @@
+// Generated by LowCodeFusion
+
+/** From: Demo/store/Put.json */
+export interface Setting {
+  v?: number;
+}
+
+/** From: Demo/store/Put.json */
+export type Tree = Record<string, Tree>;
+
+/** From: Demo/store/Put.json */
+export type PutLabels = Record<string, string>;
+
+/** From: Demo/store/Put.json */
+export type PutCounts = Record<string, number | string>;
+
+/** From: Demo/store/Put.json */
+export interface PutConfig {
+  name: string;
+}
+
+/** From: Demo/store/Put.json */
+export interface PutNested {
+  m?: Record<string, Array<string>>;
+}
+
+/** From: Demo/store/Put.json */
+export type PutAnything = Record<string, unknown>;
+
+/** From: Demo/store/Put.json */
+export type PutResult = Record<string, PutResultValue>;
+
+/** From: Demo/store/Put.json */
+export interface PutResultValue {
+  v?: number;
+}
@@ -0,0 +1,13 @@
+// Auto-generated TypeScript stub for Pliant integration: Demo
+// Right now this function only logs its name.
+import type { RunInstancesInstanceType, RunInstancesResult, RunInstancesTagSpecification } from "../_types/ec2/RunInstances_types";
+
+/**
+ * Launches EC2 instances
+ * @param ImageId ID of the AMI
+ * @param MaxCount Number of instances
+ */
+export function RunInstances(ImageId: string, MaxCount?: number, InstanceType?: RunInstancesInstanceType, StartAt?: string, Price?: number, TagSpecification?: RunInstancesTagSpecification): RunInstancesResult {
+  console.log("Function name: RunInstances");
+  return {} as RunInstancesResult;
+}
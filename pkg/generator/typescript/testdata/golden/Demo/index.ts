@@ -0,0 +1,9 @@
+// Generated by LowCodeFusion
+export * from "./_types/common_types";
+export * from "./_types/Clash_types";
+export * from "./_types/Ping_types";
+export * as ec2 from "./ec2";
+export * as net from "./net";
+export * as store from "./store";
+export * from "./Clash";
+export * from "./Ping";
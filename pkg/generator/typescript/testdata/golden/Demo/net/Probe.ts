@@ -0,0 +1,11 @@
+// Auto-generated TypeScript stub for Pliant integration: Demo
+// Right now this function only logs its name.
+import type { ProbeHost, ProbeResult } from "../_types/net/Probe_types";
+
+/**
+ * Probes a host
+ */
+export function Probe(host: ProbeHost, threshold?: number): ProbeResult {
+  console.log("Function name: Probe");
+  return {} as ProbeResult;
+}
@@ -0,0 +1,4 @@
+// Generated by LowCodeFusion
+export * from "../_types/net/common_types";
+export * from "../_types/net/Probe_types";
+export * from "./Probe";
@@ -0,0 +1,11 @@
+// Auto-generated TypeScript stub for Pliant integration: Demo
+// Right now this function only logs its name.
+import type { PutAnything, PutConfig, PutCounts, PutLabels, PutNested, PutResult, Tree } from "../_types/store/Put_types";
+
+/**
+ * Stores settings
+ */
+export function Put(labels: PutLabels, config: PutConfig, counts?: PutCounts, tree?: Tree, nested?: PutNested, anything?: PutAnything): PutResult {
+  console.log("Function name: Put");
+  return {} as PutResult;
+}
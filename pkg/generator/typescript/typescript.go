@@ -0,0 +1,45 @@
+// File: pkg/generator/typescript/typescript.go
+
+package typescript
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/strongcodr/lowcodefusion/pkg/generator"
+	"github.com/strongcodr/lowcodefusion/pkg/ir"
+)
+
+// Generator is the TypeScript target of lcf generate --lang typescript
+type Generator struct {
+	opts Options
+}
+
+func init() {
+	generator.Register(&Generator{opts: Options{Formats: DefaultFormats.Clone()}})
+}
+
+// Name selects the generator with --lang
+func (g *Generator) Name() string {
+	return "typescript"
+}
+
+// Description summarizes the generated SDK
+func (g *Generator) Description() string {
+	return "TypeScript / Node.js package with exported interfaces"
+}
+
+// Capabilities lists the optional features of the TypeScript SDK
+func (g *Generator) Capabilities() []generator.Capability {
+	return []generator.Capability{generator.TypedModels}
+}
+
+// Flags registers the TypeScript-specific flags
+func (g *Generator) Flags(fs *pflag.FlagSet) {
+	fs.StringVarP(&g.opts.PackageName, "ts-package-name", "", "", "npm package name of the TypeScript SDK (default derived from the integration)")
+	fs.Var(g.opts.Formats, "ts-format", "TypeScript type of a JSON Schema format, as format=Type[@module] (repeatable)")
+}
+
+// Generate writes the TypeScript SDK for an integration
+func (g *Generator) Generate(integration *ir.Integration, outDir string) error {
+	return Generate(integration, outDir, g.opts)
+}
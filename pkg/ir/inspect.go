@@ -0,0 +1,164 @@
+// File: pkg/ir/inspect.go
+
+package ir
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PackageSummary describes what an integration package contains
+type PackageSummary struct {
+	Integration string           `json:"integration"`
+	Version     string           `json:"version,omitempty"`
+	Operations  int              `json:"operations"`
+	Services    []ServiceSummary `json:"services"`
+	Schemas     SchemaStats      `json:"schemas"`
+}
+
+// ServiceSummary describes the operations of one service (e.g. "ec2")
+type ServiceSummary struct {
+	Name       string             `json:"name"`
+	Operations []OperationSummary `json:"operations"`
+}
+
+// OperationSummary describes a single operation
+type OperationSummary struct {
+	Name           string             `json:"name"`
+	Description    string             `json:"description,omitempty"`
+	Parameters     []ParameterSummary `json:"parameters"`
+	RequiredInputs []string           `json:"requiredInputs"`
+	OutputType     string             `json:"outputType"`
+}
+
+// ParameterSummary describes an operation input
+type ParameterSummary struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+// SchemaStats counts schema features across all inputs and outputs
+type SchemaStats struct {
+	Schemas      int `json:"schemas"`      // complex (object/map/array/union) input and output schemas
+	Definitions  int `json:"definitions"`  // entries in "definitions" blocks
+	OneOf        int `json:"oneOf"`        // schemas using oneOf or anyOf
+	CircularRefs int `json:"circularRefs"` // circular references cut off while parsing
+}
+
+// Summarize describes an integration without generating code
+func Summarize(integration *Integration) *PackageSummary {
+	summary := &PackageSummary{
+		Integration: integration.Name,
+		Version:     integration.Version,
+		Services:    []ServiceSummary{},
+	}
+
+	for _, service := range integration.Services {
+		serviceSummary := ServiceSummary{Name: service.Name}
+		for _, op := range service.Operations {
+			serviceSummary.Operations = append(serviceSummary.Operations, summarizeOperation(op))
+
+			// Walk the schemas of the operation
+			for _, param := range op.Inputs {
+				summary.Schemas.add(param.Schema)
+			}
+			if op.Output != nil {
+				summary.Schemas.add(op.Output.Schema)
+			}
+		}
+		summary.Operations += len(service.Operations)
+		summary.Services = append(summary.Services, serviceSummary)
+	}
+
+	return summary
+}
+
+// summarizeOperation describes the inputs and output of an operation
+func summarizeOperation(op *Operation) OperationSummary {
+	opSummary := OperationSummary{
+		Name:           op.Name,
+		Description:    op.Description,
+		Parameters:     []ParameterSummary{},
+		RequiredInputs: []string{},
+		OutputType:     "none",
+	}
+	for _, param := range op.Inputs {
+		opSummary.Parameters = append(opSummary.Parameters, ParameterSummary{
+			Name:        param.Name,
+			Type:        param.Schema.String(),
+			Required:    param.Required,
+			Description: param.Description,
+		})
+		if param.Required {
+			opSummary.RequiredInputs = append(opSummary.RequiredInputs, param.Name)
+		}
+	}
+	if op.Output != nil {
+		opSummary.OutputType = op.Output.Schema.String()
+	}
+	return opSummary
+}
+
+// add counts the features of a root schema
+func (st *SchemaStats) add(schema Schema) {
+	if schema.IsComplex() || len(schema.OneOf) > 0 {
+		st.Schemas++
+	}
+	st.Definitions += len(schema.Definitions)
+	st.OneOf += countSchemas(schema, func(s Schema) bool { return len(s.OneOf) > 0 })
+	st.CircularRefs += countSchemas(schema, func(s Schema) bool { return s.Circular })
+}
+
+// countSchemas counts the schemas in a schema tree matching a predicate
+func countSchemas(schema Schema, match func(Schema) bool) int {
+	count := 0
+	if match(schema) {
+		count++
+	}
+	for _, variant := range schema.OneOf {
+		count += countSchemas(variant, match)
+	}
+	for _, prop := range schema.Properties {
+		count += countSchemas(prop, match)
+	}
+	if schema.Items != nil {
+		count += countSchemas(*schema.Items, match)
+	}
+	if schema.AdditionalProperties != nil {
+		count += countSchemas(*schema.AdditionalProperties, match)
+	}
+	for _, def := range schema.Definitions {
+		count += countSchemas(def, match)
+	}
+	return count
+}
+
+// String describes a schema in a language-neutral way (e.g. "array<string(date-time)>")
+func (s Schema) String() string {
+	if name := s.RefName(); name != "" {
+		return name
+	}
+
+	switch {
+	case len(s.Enum) > 0:
+		return fmt.Sprintf("enum<%s>", strings.Join(s.Enum, "|"))
+	case s.Type == "array" && s.Items != nil:
+		return fmt.Sprintf("array<%s>", s.Items.String())
+	case s.Type == "map" && s.AdditionalProperties != nil:
+		return fmt.Sprintf("map<%s>", s.AdditionalProperties.String())
+	case len(s.OneOf) > 0:
+		variants := make([]string, 0, len(s.OneOf))
+		for _, variant := range s.OneOf {
+			variants = append(variants, variant.String())
+		}
+		return fmt.Sprintf("oneOf<%s>", strings.Join(variants, "|"))
+	case s.Type == "":
+		return "any"
+	case s.Format != "":
+		return fmt.Sprintf("%s(%s)", s.Type, s.Format)
+	default:
+		return s.Type
+	}
+}
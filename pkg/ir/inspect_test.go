@@ -0,0 +1,64 @@
+// File: pkg/ir/inspect_test.go
+
+package ir
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	integration, err := Load("../generator/testdata", "Demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	integration.Version = "1.0.0"
+	summary := Summarize(integration)
+
+	if summary.Integration != "Demo" || summary.Version != "1.0.0" || summary.Operations != 6 {
+		t.Errorf("summary = %s %s with %d operations", summary.Integration, summary.Version, summary.Operations)
+	}
+	var services []string
+	for _, service := range summary.Services {
+		var ops []string
+		for _, op := range service.Operations {
+			ops = append(ops, op.Name)
+		}
+		services = append(services, service.Name+":"+strings.Join(ops, ","))
+	}
+	if want := []string{":Clash,Ping", "ec2:RunInstances", "net:Probe", "store:AddPet,Put"}; !reflect.DeepEqual(services, want) {
+		t.Errorf("services = %v, want %v", services, want)
+	}
+
+	want := SchemaStats{Schemas: 14, Definitions: 9, OneOf: 5, CircularRefs: 2}
+	if summary.Schemas != want {
+		t.Errorf("Schemas = %+v, want %+v", summary.Schemas, want)
+	}
+
+	// Operations without inputs or output are described with empty lists and "none"
+	ping := summary.Services[0].Operations[1]
+	if ping.Parameters == nil || ping.RequiredInputs == nil || ping.OutputType != "none" {
+		t.Errorf("Ping = %+v", ping)
+	}
+
+	run := summary.Services[1].Operations[0]
+	if run.Description != "Launches EC2 instances" || !reflect.DeepEqual(run.RequiredInputs, []string{"ImageId"}) {
+		t.Errorf("RunInstances = %q, required %v", run.Description, run.RequiredInputs)
+	}
+	if p := run.Parameters[0]; p != (ParameterSummary{Name: "ImageId", Type: "string", Required: true, Description: "ID of the AMI"}) {
+		t.Errorf("ImageId = %+v", p)
+	}
+	types := make(map[string]string)
+	for _, p := range run.Parameters {
+		types[p.Name] = p.Type
+	}
+	if types["InstanceType"] != "enum<t2.micro|t3.large>" || types["StartAt"] != "string(date-time)" {
+		t.Errorf("RunInstances parameter types = %v", types)
+	}
+
+	addPet := summary.Services[3].Operations[0]
+	if addPet.Parameters[0].Type != "oneOf<Cat|Dog>" || addPet.OutputType != "oneOf<Kitten|Puppy>" {
+		t.Errorf("AddPet = %s -> %s", addPet.Parameters[0].Type, addPet.OutputType)
+	}
+}
@@ -0,0 +1,84 @@
+// File: pkg/ir/ir.go
+
+package ir
+
+import "sort"
+
+// Integration is the language-neutral description of an integration package.
+// Generators consume it instead of reading flow files themselves.
+type Integration struct {
+	Name     string     // e.g. "AWS"
+	Version  string     // e.g. "1.1.118", empty if unknown
+	Services []*Service // sorted by name
+}
+
+// Service groups the operations of one top-level directory of the integration (e.g. "ec2").
+// Operations that live directly in the integration directory belong to the service named "".
+type Service struct {
+	Name       string       // e.g. "ec2"
+	Operations []*Operation // sorted by name
+}
+
+// Operation is a single flow of the integration
+type Operation struct {
+	Name        string       // flow name as written in the flow file (e.g. "RunInstances")
+	Description string       // flow description
+	Path        []string     // directories below the integration (e.g. ["ec2"])
+	FlowPath    string       // flow file relative to the flows directory (e.g. "AWS/ec2/RunInstances.json")
+	FilePath    string       // flow file on disk
+	Inputs      []*Parameter // in flow order
+	Output      *Parameter   // nil if the flow has no output
+}
+
+// Parameter is an input or output variable of an operation
+type Parameter struct {
+	Name        string // variable name as written in the flow file
+	Description string
+	Required    bool
+	Schema      Schema
+}
+
+// Service returns the name of the service an operation belongs to
+func (op *Operation) Service() string {
+	if len(op.Path) == 0 {
+		return ""
+	}
+	return op.Path[0]
+}
+
+// Operations returns every operation of the integration, sorted by service and name
+func (in *Integration) Operations() []*Operation {
+	var ops []*Operation
+	for _, service := range in.Services {
+		ops = append(ops, service.Operations...)
+	}
+	return ops
+}
+
+// addOperation files an operation under its service
+func (in *Integration) addOperation(op *Operation) {
+	name := op.Service()
+	for _, service := range in.Services {
+		if service.Name == name {
+			service.Operations = append(service.Operations, op)
+			return
+		}
+	}
+	in.Services = append(in.Services, &Service{Name: name, Operations: []*Operation{op}})
+}
+
+// sort orders services and operations by name for deterministic output
+func (in *Integration) sort() {
+	sort.Slice(in.Services, func(i, j int) bool {
+		return in.Services[i].Name < in.Services[j].Name
+	})
+	for _, service := range in.Services {
+		ops := service.Operations
+		sort.Slice(ops, func(i, j int) bool {
+			if ops[i].FlowPath != ops[j].FlowPath && ops[i].Name == ops[j].Name {
+				return ops[i].FlowPath < ops[j].FlowPath
+			}
+			return ops[i].Name < ops[j].Name
+		})
+	}
+}
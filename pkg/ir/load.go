@@ -0,0 +1,176 @@
+// File: pkg/ir/load.go
+
+package ir
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FlowFile represents the JSON structure of a flow file
+type FlowFile struct {
+	Name      string    `json:"name"`
+	Processes []Process `json:"processes"`
+	Meta      FlowMeta  `json:"meta"`
+}
+
+// Process represents a process within a flow
+type Process struct {
+	Name      string     `json:"name"`
+	Variables []Variable `json:"variables"`
+}
+
+// Variable represents a variable within a process
+type Variable struct {
+	Name     string       `json:"name"`
+	IsInput  bool         `json:"isInput"`
+	IsOutput bool         `json:"isOutput"`
+	Required bool         `json:"required"`
+	Meta     VariableMeta `json:"meta"`
+	Type     interface{}  `json:"type"`
+}
+
+// VariableMeta contains metadata for a variable
+type VariableMeta struct {
+	Description string `json:"description"`
+}
+
+// FlowMeta contains metadata for a flow
+type FlowMeta struct {
+	Info string `json:"info"`
+}
+
+// Load scans an extracted package and builds the integration's IR.
+// Flows are read from <srcDir>/flows/<integrationName>.
+func Load(srcDir string, integrationName string) (*Integration, error) {
+	// Find the flows directory
+	flowsDir := filepath.Join(srcDir, "flows")
+	if _, err := os.Stat(flowsDir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("flows directory not found in %s", srcDir)
+	}
+
+	// Find the integration directory (e.g., AWS)
+	integrationDir := filepath.Join(flowsDir, integrationName)
+	if _, err := os.Stat(integrationDir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("integration directory %s not found in flows", integrationName)
+	}
+
+	integration := &Integration{Name: integrationName}
+
+	// Walk through the directory structure
+	err := filepath.Walk(integrationDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip directories
+		if info.IsDir() {
+			return nil
+		}
+
+		// Only process JSON files
+		if !strings.HasSuffix(strings.ToLower(info.Name()), ".json") {
+			return nil
+		}
+
+		op, err := loadOperation(flowsDir, integrationDir, path)
+		if err != nil {
+			return err
+		}
+		integration.addOperation(op)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	integration.sort()
+	return integration, nil
+}
+
+// loadOperation parses a single flow file
+func loadOperation(flowsDir, integrationDir, path string) (*Operation, error) {
+	// Get the relative path from the integration directory
+	// e.g., "ec2/DescribeIdFormat.json" -> ["ec2"]
+	relPath, err := filepath.Rel(integrationDir, path)
+	if err != nil {
+		return nil, err
+	}
+	var dirs []string
+	if dir := filepath.Dir(relPath); dir != "." {
+		dirs = strings.Split(dir, string(filepath.Separator))
+	}
+
+	flowPath, err := filepath.Rel(flowsDir, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read and parse the flow file
+	fileContent, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file %s: %v", path, err)
+	}
+
+	var flowFile FlowFile
+	if err := json.Unmarshal(fileContent, &flowFile); err != nil {
+		return nil, fmt.Errorf("error parsing JSON from %s: %v", path, err)
+	}
+
+	// Check if there's more than one process
+	if len(flowFile.Processes) != 1 {
+		return nil, fmt.Errorf("file %s has %d processes, expected exactly 1", path, len(flowFile.Processes))
+	}
+
+	op := &Operation{
+		Name:        flowFile.Name,
+		Description: flowFile.Meta.Info,
+		Path:        dirs,
+		FlowPath:    filepath.ToSlash(flowPath),
+		FilePath:    path,
+		Inputs:      []*Parameter{},
+	}
+
+	// Process variables
+	for _, variable := range flowFile.Processes[0].Variables {
+		if !variable.IsInput && !variable.IsOutput {
+			continue
+		}
+
+		param := &Parameter{
+			Name:        variable.Name,
+			Description: variable.Meta.Description,
+			Required:    variable.Required,
+			Schema:      parseVariableType(variable.Name, variable.Type),
+		}
+
+		// Process input parameters
+		if variable.IsInput {
+			op.Inputs = append(op.Inputs, param)
+		}
+
+		// Process output (return type)
+		if variable.IsOutput {
+			op.Output = param
+		}
+	}
+
+	return op, nil
+}
+
+// parseVariableType converts a variable's type, resolving refs against its own definitions
+func parseVariableType(name string, typeInfo interface{}) Schema {
+	definitions := make(map[string]interface{})
+	if typeObj, ok := typeInfo.(map[string]interface{}); ok {
+		if defs, ok := typeObj["definitions"].(map[string]interface{}); ok {
+			definitions = defs
+		}
+	}
+
+	schema := ParseSchema(name, typeInfo, definitions)
+	schema.IsRoot = true
+	return schema
+}
@@ -0,0 +1,90 @@
+// File: pkg/ir/load_test.go
+
+package ir
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFlow writes a flow file below <dir>/flows
+func writeFlow(t *testing.T, dir, relPath, content string) {
+	t.Helper()
+	path := filepath.Join(dir, "flows", filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+const runInstancesFlow = `{
+	"name": "RunInstances",
+	"meta": {"info": "Launches instances"},
+	"processes": [{"name": "main", "variables": [
+		{"name": "ImageId", "isInput": true, "required": true, "type": "string", "meta": {"description": "AMI"}},
+		{"name": "MaxCount", "isInput": true, "type": "integer"},
+		{"name": "scratch", "type": "string"},
+		{"name": "result", "isOutput": true, "type": {"type": "object", "properties": {"InstanceId": {"type": "string"}}}}
+	]}]
+}`
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeFlow(t, dir, "AWS/ec2/RunInstances.json", runInstancesFlow)
+	writeFlow(t, dir, "AWS/ec2/DescribeInstances.json", `{"name": "DescribeInstances", "processes": [{"name": "main", "variables": []}]}`)
+	writeFlow(t, dir, "AWS/Ping.json", `{"name": "Ping", "processes": [{"name": "main", "variables": []}]}`)
+	writeFlow(t, dir, "AWS/README.md", "not a flow")
+
+	integration, err := Load(dir, "AWS")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, op := range integration.Operations() {
+		names = append(names, op.Service()+":"+op.Name)
+	}
+	if got := strings.Join(names, ","); got != ":Ping,ec2:DescribeInstances,ec2:RunInstances" {
+		t.Errorf("operations = %s", got)
+	}
+
+	op := integration.Services[1].Operations[1]
+	if op.FlowPath != "AWS/ec2/RunInstances.json" || op.Description != "Launches instances" {
+		t.Errorf("RunInstances = %q, %q", op.FlowPath, op.Description)
+	}
+	if len(op.Inputs) != 2 || op.Inputs[0].Name != "ImageId" || !op.Inputs[0].Required || op.Inputs[1].Required {
+		t.Fatalf("inputs = %+v", op.Inputs)
+	}
+	if op.Inputs[0].Description != "AMI" || op.Inputs[1].Schema.Type != "integer" {
+		t.Errorf("inputs = %+v, %+v", op.Inputs[0], op.Inputs[1])
+	}
+	if op.Output == nil || op.Output.Schema.Type != "object" || !op.Output.Schema.IsRoot {
+		t.Errorf("output = %+v", op.Output)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Load(dir, "AWS"); err == nil || !strings.Contains(err.Error(), "flows directory not found") {
+		t.Errorf("Load without flows: %v", err)
+	}
+
+	writeFlow(t, dir, "Slack/Post.json", runInstancesFlow)
+	if _, err := Load(dir, "AWS"); err == nil || !strings.Contains(err.Error(), "AWS not found") {
+		t.Errorf("Load of a missing integration: %v", err)
+	}
+
+	writeFlow(t, dir, "AWS/Broken.json", `{"name": "Broken", "processes": []}`)
+	if _, err := Load(dir, "AWS"); err == nil || !strings.Contains(err.Error(), "expected exactly 1") {
+		t.Errorf("Load of a flow without a process: %v", err)
+	}
+
+	writeFlow(t, dir, "AWS/Broken.json", `{"name": `)
+	if _, err := Load(dir, "AWS"); err == nil || !strings.Contains(err.Error(), "error parsing JSON") {
+		t.Errorf("Load of invalid JSON: %v", err)
+	}
+}
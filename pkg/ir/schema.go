@@ -0,0 +1,524 @@
+// File: pkg/ir/schema.go
+
+package ir
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Schema is a language-neutral type extracted from a JSON schema
+type Schema struct {
+	Name                 string            // Name of the type
+	Type                 string            // Type (string, integer, object, array, etc.)
+	Format               string            // Format (date-time, etc.)
+	Description          string            // Description of the type
+	Properties           map[string]Schema // Object properties
+	AdditionalProperties *Schema           // Value type of the keys not listed in Properties, nil if unspecified
+	Items                *Schema           // Array item type
+	Enum                 []string          // Enum values
+	Ref                  string            // Reference to another type
+	Required             []string          // Required properties
+	OneOf                []Schema          // Union variants (oneOf and anyOf)
+	Discriminator        *Discriminator    // Property telling the variants apart, nil if none
+	IsRoot               bool              // Is this a root type (not a nested type)
+	Definitions          map[string]Schema // Type definitions (for root types)
+	Default              interface{}       // Default value, nil if none
+	Circular             bool              // Cut off at a circular reference
+}
+
+// Discriminator names the property whose value selects a union variant
+type Discriminator struct {
+	PropertyName string            // Property holding the variant's value
+	Mapping      map[string]string // Property value -> "$ref" of the variant
+}
+
+// pathTracker is used to track the JSON schema reference path to detect circular references
+type pathTracker struct {
+	paths map[string]bool
+}
+
+// newPathTracker creates a new pathTracker
+func newPathTracker() *pathTracker {
+	return &pathTracker{
+		paths: make(map[string]bool),
+	}
+}
+
+// has checks if a path has been visited
+func (p *pathTracker) has(path string) bool {
+	return p.paths[path]
+}
+
+// add marks a path as visited
+func (p *pathTracker) add(path string) {
+	p.paths[path] = true
+}
+
+// remove marks a path as no longer visited
+func (p *pathTracker) remove(path string) {
+	delete(p.paths, path)
+}
+
+// ParseSchema converts a JSON schema object to a Schema.
+// definitions holds the "definitions" block that "$ref"s are resolved against.
+func ParseSchema(typeName string, typeInfo interface{}, definitions map[string]interface{}) Schema {
+	schema := parseSchemaWithTracker(typeName, typeInfo, definitions, newPathTracker())
+	applyDiscriminators(schema)
+	return schema
+}
+
+// parseSchemaWithTracker converts a JSON schema object to a Schema with path tracking to avoid circular references
+func parseSchemaWithTracker(
+	typeName string,
+	typeInfo interface{},
+	definitions map[string]interface{},
+	tracker *pathTracker,
+) Schema {
+	schemaType := Schema{
+		Name:       typeName,
+		Properties: make(map[string]Schema),
+	}
+
+	// Handle simple string type
+	if typeStr, ok := typeInfo.(string); ok {
+		schemaType.Type = typeStr
+		return schemaType
+	}
+
+	// Handle complex type (object with properties)
+	if typeObj, ok := typeInfo.(map[string]interface{}); ok {
+		// Get direct type property
+		if typeType, ok := typeObj["type"].(string); ok {
+			schemaType.Type = typeType
+		}
+
+		// Get format if available
+		if format, ok := typeObj["format"].(string); ok {
+			schemaType.Format = format
+		}
+
+		// Get description if available
+		if desc, ok := typeObj["description"].(string); ok {
+			schemaType.Description = desc
+		}
+
+		// Get default value if available
+		if def, ok := typeObj["default"]; ok {
+			schemaType.Default = def
+		}
+
+		// Get required properties
+		if req, ok := typeObj["required"].([]interface{}); ok {
+			for _, r := range req {
+				if reqStr, ok := r.(string); ok {
+					schemaType.Required = append(schemaType.Required, reqStr)
+				}
+			}
+		}
+
+		// Handle array type
+		if schemaType.Type == "array" {
+			if items, ok := typeObj["items"].(map[string]interface{}); ok {
+				// Check for circular reference
+				itemPath := typeName + ".items"
+				if !tracker.has(itemPath) {
+					tracker.add(itemPath)
+					itemType := parseSchemaWithTracker(typeName+"Item", items, definitions, tracker)
+					schemaType.Items = &itemType
+					tracker.remove(itemPath)
+				} else {
+					// Circular reference detected, use Any for items
+					schemaType.Items = &Schema{Name: "Any", Type: "any", Circular: true}
+				}
+			}
+		}
+
+		// Handle object type with properties
+		if props, ok := typeObj["properties"].(map[string]interface{}); ok &&
+			(schemaType.Type == "object" || schemaType.Type == "") {
+			schemaType.Type = "object"
+
+			for propName, propType := range props {
+				// Check for circular reference
+				propPath := typeName + ".properties." + propName
+				if !tracker.has(propPath) {
+					tracker.add(propPath)
+					schemaType.Properties[propName] = parseSchemaWithTracker(
+						propName,
+						propType,
+						definitions,
+						tracker,
+					)
+					tracker.remove(propPath)
+				} else {
+					// Circular reference detected, use Any for this property
+					schemaType.Properties[propName] = Schema{Name: propName, Type: "any", Circular: true}
+				}
+			}
+		}
+
+		// Handle map values: additionalProperties and patternProperties describe the
+		// values of keys that are not listed in properties
+		if values := parseMapValues(typeName, typeObj, definitions, tracker); values != nil &&
+			(schemaType.Type == "object" || schemaType.Type == "") {
+			schemaType.AdditionalProperties = values
+			if len(schemaType.Properties) == 0 {
+				schemaType.Type = "map"
+			}
+		}
+
+		// Handle enum values
+		if enumValues, ok := typeObj["enum"].([]interface{}); ok {
+			for _, val := range enumValues {
+				if strVal, ok := val.(string); ok {
+					schemaType.Enum = append(schemaType.Enum, strVal)
+				} else if numVal, ok := val.(float64); ok {
+					schemaType.Enum = append(schemaType.Enum, fmt.Sprintf("%v", numVal))
+				} else if boolVal, ok := val.(bool); ok {
+					schemaType.Enum = append(schemaType.Enum, fmt.Sprintf("%v", boolVal))
+				}
+			}
+		}
+
+		// Handle schema reference
+		if ref, ok := typeObj["$ref"].(string); ok {
+			schemaType.Ref = ref
+			// Get the basic type information from the reference, but don't resolve nested references
+			if refSchema, circular, ok := resolveRef(ref, definitions, tracker); circular {
+				schemaType.Circular = true
+			} else if ok {
+				// Remember if the definition leads back into a cycle
+				if countSchemas(refSchema, func(s Schema) bool { return s.Circular }) > 0 {
+					schemaType.Circular = true
+				}
+				schemaType.Type = refSchema.Type
+				schemaType.Format = refSchema.Format
+			}
+		}
+
+		// Handle oneOf and anyOf, both become a union of their variants
+		for _, keyword := range []string{"oneOf", "anyOf"} {
+			variants, ok := typeObj[keyword].([]interface{})
+			if !ok {
+				continue
+			}
+			variantPath := typeName + "." + keyword
+			if tracker.has(variantPath) {
+				continue
+			}
+			tracker.add(variantPath)
+			for _, variant := range variants {
+				variantSchema := parseSchemaWithTracker(typeName+"OneOf", variant, definitions, tracker)
+				schemaType.OneOf = append(schemaType.OneOf, variantSchema)
+			}
+			tracker.remove(variantPath)
+		}
+		if disc, ok := typeObj["discriminator"].(map[string]interface{}); ok {
+			schemaType.Discriminator = parseDiscriminator(disc)
+		}
+
+		// Handle allOf, the members are merged into a single schema
+		if members, ok := typeObj["allOf"].([]interface{}); ok {
+			mergeAllOf(&schemaType, members, definitions, tracker)
+		}
+
+		// A union of objects is described by its variants
+		if len(schemaType.OneOf) > 0 && schemaType.Type == "object" && len(schemaType.Properties) == 0 {
+			schemaType.Type = ""
+		}
+
+		// "not" is dropped: it only excludes values, so the schema keeps the type it has without it,
+		// and generated code does not reject the excluded values
+
+		// Handle definitions (only for root types) - with limits
+		if defs, ok := typeObj["definitions"].(map[string]interface{}); ok {
+			schemaType.Definitions = make(map[string]Schema)
+
+			for defName, defType := range defs {
+				// Check for circular reference
+				defPath := "definitions." + defName
+				if !tracker.has(defPath) {
+					tracker.add(defPath)
+					schemaType.Definitions[defName] = parseSchemaWithTracker(defName, defType, defs, tracker)
+					tracker.remove(defPath)
+				} else {
+					// Just create a placeholder for circular references
+					schemaType.Definitions[defName] = Schema{Name: defName, Type: "any", Circular: true}
+				}
+			}
+		}
+	}
+
+	return schemaType
+}
+
+// resolveRef parses the definition a "$ref" points at. circular is true if the
+// definition is being parsed already, ok is false if it cannot be resolved.
+func resolveRef(ref string, definitions map[string]interface{}, tracker *pathTracker) (schema Schema, circular, ok bool) {
+	refPath := fmt.Sprintf("$ref:%s", ref)
+	if tracker.has(refPath) {
+		return Schema{}, true, false
+	}
+
+	parts := strings.Split(ref, "/")
+	if len(parts) < 3 || parts[1] != "definitions" || definitions == nil {
+		return Schema{}, false, false
+	}
+	refTypeName := parts[len(parts)-1]
+	defType, ok := definitions[refTypeName]
+	if !ok {
+		return Schema{}, false, false
+	}
+
+	tracker.add(refPath)
+	schema = parseSchemaWithTracker(refTypeName, defType, definitions, tracker)
+	tracker.remove(refPath)
+	return schema, false, true
+}
+
+// mergeAllOf merges the allOf members into a schema. Properties, required lists and
+// circular markers add up; other keywords come from the schema or the first member setting them.
+// A lone "$ref" member without own properties stays a reference.
+func mergeAllOf(schema *Schema, members []interface{}, definitions map[string]interface{}, tracker *pathTracker) {
+	allOfPath := schema.Name + ".allOf"
+	if tracker.has(allOfPath) {
+		return
+	}
+	tracker.add(allOfPath)
+	defer tracker.remove(allOfPath)
+
+	for _, member := range members {
+		memberSchema := parseSchemaWithTracker(schema.Name+"AllOf", member, definitions, tracker)
+
+		if memberSchema.Ref != "" {
+			if len(members) == 1 && len(schema.Properties) == 0 && schema.Ref == "" {
+				schema.Ref = memberSchema.Ref
+				schema.Circular = schema.Circular || memberSchema.Circular
+				if schema.Type == "" {
+					schema.Type = memberSchema.Type
+				}
+				return
+			}
+
+			// Merge the referenced definition itself
+			refSchema, circular, ok := resolveRef(memberSchema.Ref, definitions, tracker)
+			if circular {
+				schema.Circular = true
+				continue
+			}
+			if !ok {
+				continue
+			}
+			memberSchema = refSchema
+		}
+
+		for propName, prop := range memberSchema.Properties {
+			if _, ok := schema.Properties[propName]; !ok {
+				schema.Properties[propName] = prop
+			}
+		}
+		for _, req := range memberSchema.Required {
+			if !schema.IsRequired(req) {
+				schema.Required = append(schema.Required, req)
+			}
+		}
+		if schema.Type == "" {
+			schema.Type = memberSchema.Type
+		}
+		if schema.Format == "" {
+			schema.Format = memberSchema.Format
+		}
+		if schema.Description == "" {
+			schema.Description = memberSchema.Description
+		}
+		if schema.Default == nil {
+			schema.Default = memberSchema.Default
+		}
+		if len(schema.Enum) == 0 {
+			schema.Enum = memberSchema.Enum
+		}
+		if schema.Items == nil {
+			schema.Items = memberSchema.Items
+		}
+		if schema.AdditionalProperties == nil {
+			schema.AdditionalProperties = memberSchema.AdditionalProperties
+		}
+		if len(schema.OneOf) == 0 {
+			schema.OneOf = memberSchema.OneOf
+			schema.Discriminator = memberSchema.Discriminator
+		}
+		schema.Circular = schema.Circular || memberSchema.Circular
+	}
+
+	if len(schema.Properties) > 0 {
+		schema.Type = "object"
+	}
+}
+
+// parseMapValues returns the schema of the values of unlisted object keys, or nil if any
+// value is allowed. The schemas of several patternProperties become a union.
+func parseMapValues(
+	typeName string,
+	typeObj map[string]interface{},
+	definitions map[string]interface{},
+	tracker *pathTracker,
+) *Schema {
+	var rawValues []interface{}
+	if additional, ok := typeObj["additionalProperties"].(map[string]interface{}); ok {
+		rawValues = append(rawValues, additional)
+	}
+	if patterns, ok := typeObj["patternProperties"].(map[string]interface{}); ok {
+		patternNames := make([]string, 0, len(patterns))
+		for pattern := range patterns {
+			patternNames = append(patternNames, pattern)
+		}
+		sort.Strings(patternNames)
+		for _, pattern := range patternNames {
+			rawValues = append(rawValues, patterns[pattern])
+		}
+	}
+	if len(rawValues) == 0 {
+		return nil
+	}
+
+	valuesPath := typeName + ".additionalProperties"
+	if tracker.has(valuesPath) {
+		return &Schema{Name: typeName + "Value", Type: "any", Circular: true}
+	}
+	tracker.add(valuesPath)
+	defer tracker.remove(valuesPath)
+
+	values := make([]Schema, 0, len(rawValues))
+	for _, rawValue := range rawValues {
+		value := parseSchemaWithTracker(typeName+"Value", rawValue, definitions, tracker)
+		// An empty schema allows any value
+		if value.Type == "" && value.Ref == "" && len(value.OneOf) == 0 && len(value.Enum) == 0 {
+			return nil
+		}
+		values = append(values, value)
+	}
+	if len(values) == 1 {
+		return &values[0]
+	}
+	return &Schema{Name: typeName + "Value", Properties: make(map[string]Schema), OneOf: values}
+}
+
+// parseDiscriminator reads an OpenAPI discriminator object
+func parseDiscriminator(disc map[string]interface{}) *Discriminator {
+	propName, ok := disc["propertyName"].(string)
+	if !ok {
+		return nil
+	}
+	result := &Discriminator{PropertyName: propName, Mapping: make(map[string]string)}
+	if mapping, ok := disc["mapping"].(map[string]interface{}); ok {
+		for value, ref := range mapping {
+			if refStr, ok := ref.(string); ok {
+				result.Mapping[value] = refStr
+			}
+		}
+	}
+	return result
+}
+
+// applyDiscriminators narrows the discriminator property of every union variant that
+// refers to a definition to the variant's value, so targets can tell the variants apart.
+// Without a mapping, the value is the definition name.
+func applyDiscriminators(root Schema) {
+	var visit func(s Schema)
+	visit = func(s Schema) {
+		if s.Discriminator != nil {
+			values := make(map[string]string) // definition name -> value
+			for value, ref := range s.Discriminator.Mapping {
+				values[Schema{Ref: ref}.RefName()] = value
+			}
+			if len(values) == 0 {
+				for _, variant := range s.OneOf {
+					if name := variant.RefName(); name != "" {
+						values[name] = name
+					}
+				}
+			}
+			for defName, value := range values {
+				narrowProperty(root.Definitions[defName], s.Discriminator.PropertyName, value)
+			}
+		}
+		for _, variant := range s.OneOf {
+			visit(variant)
+		}
+		for _, prop := range s.Properties {
+			visit(prop)
+		}
+		if s.Items != nil {
+			visit(*s.Items)
+		}
+		if s.AdditionalProperties != nil {
+			visit(*s.AdditionalProperties)
+		}
+	}
+
+	visit(root)
+	for _, name := range root.DefinitionNames() {
+		visit(root.Definitions[name])
+	}
+}
+
+// narrowProperty restricts a string property of an object schema to a single value
+func narrowProperty(s Schema, propName, value string) {
+	prop, ok := s.Properties[propName]
+	if !ok || len(prop.Enum) > 0 || (prop.Type != "string" && prop.Type != "") {
+		return
+	}
+	prop.Type = "string"
+	prop.Enum = []string{value}
+	s.Properties[propName] = prop
+}
+
+// RefName returns the name of the type a "$ref" points at (e.g. "GroupIdentifier")
+func (s Schema) RefName() string {
+	if s.Ref == "" {
+		return ""
+	}
+	parts := strings.Split(s.Ref, "/")
+	return parts[len(parts)-1]
+}
+
+// IsRequired reports whether an object property is listed as required
+func (s Schema) IsRequired(propName string) bool {
+	for _, req := range s.Required {
+		if req == propName {
+			return true
+		}
+	}
+	return false
+}
+
+// PropertyNames returns the object property names in a stable order
+func (s Schema) PropertyNames() []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefinitionNames returns the definition names in a stable order
+func (s Schema) DefinitionNames() []string {
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsComplex reports whether the schema describes a structured value (object, map or array)
+func (s Schema) IsComplex() bool {
+	switch s.Type {
+	case "object", "map", "array":
+		return true
+	}
+	return false
+}
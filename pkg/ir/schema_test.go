@@ -0,0 +1,277 @@
+// File: pkg/ir/schema_test.go
+
+package ir
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// parse decodes a JSON schema and parses it the way flow variables are parsed
+func parse(t *testing.T, name, schemaJSON string) Schema {
+	t.Helper()
+	var typeInfo interface{}
+	if err := json.Unmarshal([]byte(schemaJSON), &typeInfo); err != nil {
+		t.Fatalf("invalid test schema: %v", err)
+	}
+	return parseVariableType(name, typeInfo)
+}
+
+func TestParseSchemaBasics(t *testing.T) {
+	s := parse(t, "instance", `{
+		"type": "object",
+		"description": "An instance",
+		"required": ["id"],
+		"properties": {
+			"id": {"type": "string"},
+			"launched": {"type": "string", "format": "date-time"},
+			"state": {"type": "string", "enum": ["running", "stopped"], "default": "running"},
+			"tags": {"type": "array", "items": {"$ref": "#/definitions/Tag"}},
+			"count": "integer"
+		},
+		"definitions": {
+			"Tag": {"type": "object", "properties": {"Key": {"type": "string"}, "Value": {"type": "string"}}}
+		}
+	}`)
+
+	if s.Type != "object" || !s.IsRoot || s.Description != "An instance" {
+		t.Errorf("root = %s (root %v, %q)", s.Type, s.IsRoot, s.Description)
+	}
+	if got := s.PropertyNames(); !reflect.DeepEqual(got, []string{"count", "id", "launched", "state", "tags"}) {
+		t.Errorf("PropertyNames = %v", got)
+	}
+	if !s.IsRequired("id") || s.IsRequired("state") {
+		t.Errorf("Required = %v", s.Required)
+	}
+	if p := s.Properties["launched"]; p.Format != "date-time" {
+		t.Errorf("launched format = %q", p.Format)
+	}
+	if p := s.Properties["state"]; !reflect.DeepEqual(p.Enum, []string{"running", "stopped"}) || p.Default != "running" {
+		t.Errorf("state = %v, default %v", p.Enum, p.Default)
+	}
+	if p := s.Properties["count"]; p.Type != "integer" {
+		t.Errorf("count type = %q", p.Type)
+	}
+
+	tags := s.Properties["tags"]
+	if tags.Type != "array" || tags.Items == nil || tags.Items.RefName() != "Tag" || tags.Items.Type != "object" {
+		t.Errorf("tags = %s", tags)
+	}
+	if tag, ok := s.Definitions["Tag"]; !ok || tag.PropertyNames()[0] != "Key" {
+		t.Errorf("Definitions = %v", s.DefinitionNames())
+	}
+}
+
+func TestParseSchemaCircular(t *testing.T) {
+	s := parse(t, "tree", `{
+		"$ref": "#/definitions/Node",
+		"definitions": {
+			"Node": {"type": "object", "properties": {
+				"name": {"type": "string"},
+				"children": {"type": "array", "items": {"$ref": "#/definitions/Node"}}
+			}}
+		}
+	}`)
+
+	if s.Type != "object" || !s.Circular {
+		t.Errorf("root = %s, circular %v, want a circular object", s, s.Circular)
+	}
+	node := s.Definitions["Node"]
+	children := node.Properties["children"]
+	if children.Items == nil || !children.Items.Circular {
+		t.Errorf("Node.children items should be marked circular: %s", children)
+	}
+}
+
+func TestParseSchemaAllOf(t *testing.T) {
+	s := parse(t, "instance", `{
+		"type": "object",
+		"properties": {
+			"base": {"allOf": [{"$ref": "#/definitions/Base"}]},
+			"merged": {"allOf": [
+				{"$ref": "#/definitions/Base"},
+				{"type": "object", "required": ["size"], "properties": {
+					"id": {"type": "integer"},
+					"size": {"type": "integer"}
+				}}
+			]}
+		},
+		"definitions": {
+			"Base": {"type": "object", "description": "Common fields", "required": ["id"], "properties": {
+				"id": {"type": "string"},
+				"name": {"type": "string"}
+			}}
+		}
+	}`)
+
+	// A lone reference stays a reference
+	if base := s.Properties["base"]; base.RefName() != "Base" || base.Type != "object" || len(base.Properties) != 0 {
+		t.Errorf("base = %s with %d properties, want a reference to Base", base, len(base.Properties))
+	}
+
+	merged := s.Properties["merged"]
+	if merged.Ref != "" || merged.Type != "object" || merged.Description != "Common fields" {
+		t.Errorf("merged = %s (%q), want an inline object", merged, merged.Description)
+	}
+	if got := merged.PropertyNames(); !reflect.DeepEqual(got, []string{"id", "name", "size"}) {
+		t.Errorf("merged properties = %v", got)
+	}
+	if !reflect.DeepEqual(merged.Required, []string{"id", "size"}) {
+		t.Errorf("merged required = %v", merged.Required)
+	}
+	// The first member to define a property wins
+	if id := merged.Properties["id"]; id.Type != "string" {
+		t.Errorf("merged id = %s, want string from Base", id)
+	}
+}
+
+func TestParseSchemaUnions(t *testing.T) {
+	s := parse(t, "value", `{
+		"type": "object",
+		"properties": {
+			"either": {"oneOf": [{"type": "string"}, {"type": "integer"}]},
+			"any": {"anyOf": [{"$ref": "#/definitions/Cat"}, {"type": "null"}]},
+			"pets": {"type": "array", "items": {"oneOf": [{"$ref": "#/definitions/Cat"}, {"$ref": "#/definitions/Dog"}]}}
+		},
+		"definitions": {
+			"Cat": {"type": "object", "properties": {"meow": {"type": "boolean"}}},
+			"Dog": {"type": "object", "properties": {"bark": {"type": "boolean"}}}
+		}
+	}`)
+
+	tests := map[string]string{
+		"either": "oneOf<string|integer>",
+		"any":    "oneOf<Cat|null>",
+		"pets":   "array<oneOf<Cat|Dog>>",
+	}
+	for name, want := range tests {
+		if got := s.Properties[name].String(); got != want {
+			t.Errorf("%s = %s, want %s", name, got, want)
+		}
+	}
+	if pets := s.Properties["pets"]; pets.Items.Type != "" {
+		t.Errorf("a union of objects should not be typed itself, got %q", pets.Items.Type)
+	}
+}
+
+func TestParseSchemaDiscriminator(t *testing.T) {
+	tests := []struct {
+		name          string
+		discriminator string
+		want          map[string][]string // definition -> enum of its "kind" property
+	}{
+		{
+			name:          "mapping",
+			discriminator: `{"propertyName": "kind", "mapping": {"cat": "#/definitions/Cat", "dog": "#/definitions/Dog"}}`,
+			want:          map[string][]string{"Cat": {"cat"}, "Dog": {"dog"}},
+		},
+		{
+			name:          "definition names",
+			discriminator: `{"propertyName": "kind"}`,
+			want:          map[string][]string{"Cat": {"Cat"}, "Dog": {"Dog"}},
+		},
+	}
+	for _, tt := range tests {
+		s := parse(t, "pet", `{
+			"oneOf": [{"$ref": "#/definitions/Cat"}, {"$ref": "#/definitions/Dog"}],
+			"discriminator": `+tt.discriminator+`,
+			"definitions": {
+				"Cat": {"type": "object", "properties": {"kind": {"type": "string"}, "meow": {"type": "boolean"}}},
+				"Dog": {"type": "object", "properties": {"kind": {"type": "string"}, "bark": {"type": "boolean"}}}
+			}
+		}`)
+
+		if s.Discriminator == nil || s.Discriminator.PropertyName != "kind" {
+			t.Fatalf("%s: Discriminator = %+v", tt.name, s.Discriminator)
+		}
+		for def, enum := range tt.want {
+			kind := s.Definitions[def].Properties["kind"]
+			if kind.Type != "string" || !reflect.DeepEqual(kind.Enum, enum) {
+				t.Errorf("%s: %s.kind = %s, want enum %v", tt.name, def, kind, enum)
+			}
+		}
+	}
+
+	// Properties that are already restricted are left alone
+	s := parse(t, "pet", `{
+		"oneOf": [{"$ref": "#/definitions/Cat"}],
+		"discriminator": {"propertyName": "kind"},
+		"definitions": {"Cat": {"type": "object", "properties": {"kind": {"type": "string", "enum": ["feline"]}}}}
+	}`)
+	if kind := s.Definitions["Cat"].Properties["kind"]; !reflect.DeepEqual(kind.Enum, []string{"feline"}) {
+		t.Errorf("Cat.kind = %s, want the original enum", kind)
+	}
+}
+
+func TestParseSchemaDropsNot(t *testing.T) {
+	s := parse(t, "name", `{"type": "string", "not": {"enum": ["root"]}}`)
+	if s.Type != "string" || len(s.Enum) != 0 {
+		t.Errorf("name = %s, want a plain string", s)
+	}
+}
+
+func TestParseSchemaMaps(t *testing.T) {
+	s := parse(t, "config", `{
+		"type": "object",
+		"properties": {
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"counts": {"additionalProperties": {"type": "integer"}},
+			"tags": {"type": "object", "additionalProperties": {"$ref": "#/definitions/Tag"}},
+			"nested": {"type": "object", "additionalProperties": {"type": "array", "items": {"type": "string"}}},
+			"patterned": {"type": "object", "patternProperties": {
+				"^s_": {"type": "string"},
+				"^n_": {"type": "number"}
+			}},
+			"open": {"type": "object", "additionalProperties": {}},
+			"closed": {"type": "object", "additionalProperties": false},
+			"mixed": {"type": "object", "properties": {"id": {"type": "string"}}, "additionalProperties": {"type": "boolean"}}
+		},
+		"definitions": {
+			"Tag": {"type": "object", "properties": {"Value": {"type": "string"}}}
+		}
+	}`)
+
+	tests := []struct {
+		prop string
+		want string
+	}{
+		{"labels", "map<string>"},
+		{"counts", "map<integer>"},
+		{"tags", "map<Tag>"},
+		{"nested", "map<array<string>>"},
+		// Pattern schemas are taken in pattern order
+		{"patterned", "map<oneOf<number|string>>"},
+		// Maps without a value schema stay plain objects
+		{"open", "object"},
+		{"closed", "object"},
+	}
+	for _, tt := range tests {
+		if got := s.Properties[tt.prop].String(); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.prop, got, tt.want)
+		}
+	}
+
+	// An object with properties keeps them and records the type of the other keys
+	mixed := s.Properties["mixed"]
+	if mixed.Type != "object" || len(mixed.Properties) != 1 || mixed.AdditionalProperties == nil ||
+		mixed.AdditionalProperties.Type != "boolean" {
+		t.Errorf("mixed = %s with additional properties %v", mixed, mixed.AdditionalProperties)
+	}
+	if !mixed.IsComplex() || !s.Properties["labels"].IsComplex() {
+		t.Error("maps and objects should be complex")
+	}
+}
+
+func TestParseSchemaCircularMap(t *testing.T) {
+	s := parse(t, "tree", `{
+		"$ref": "#/definitions/Tree",
+		"definitions": {
+			"Tree": {"type": "object", "additionalProperties": {"$ref": "#/definitions/Tree"}}
+		}
+	}`)
+	tree := s.Definitions["Tree"]
+	if tree.Type != "map" || tree.AdditionalProperties == nil || !tree.AdditionalProperties.Circular {
+		t.Errorf("Tree = %s, want a map of circular references", tree)
+	}
+}
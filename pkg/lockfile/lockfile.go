@@ -0,0 +1,115 @@
+// File: pkg/lockfile/lockfile.go
+
+package lockfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileName is the name of the lockfile written next to a generated SDK
+const FileName = "lcf.lock"
+
+// formatVersion is the current lockfile format version
+const formatVersion = 1
+
+// Lockfile records which integration packages an SDK was generated from
+type Lockfile struct {
+	LockfileVersion int     `json:"lockfileVersion"`
+	Integrations    []Entry `json:"integrations"`
+}
+
+// Entry pins a single integration package
+type Entry struct {
+	Name    string `json:"name"`    // e.g. "AWS"
+	Version string `json:"version"` // e.g. "1.1.118"
+	File    string `json:"file"`    // e.g. "AWS_1.1.118.ssi.zip"
+	URL     string `json:"url"`     // where the package was downloaded from
+	SHA256  string `json:"sha256"`  // hex-encoded digest of the package
+}
+
+// Path returns the lockfile path for an output directory
+func Path(dir string) string {
+	return filepath.Join(dir, FileName)
+}
+
+// Read loads the lockfile from an output directory.
+// The returned error wraps os.ErrNotExist if there is no lockfile.
+func Read(dir string) (*Lockfile, error) {
+	path := Path(dir)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lockfile: %w", err)
+	}
+
+	var lock Lockfile
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("invalid lockfile %s: %w", path, err)
+	}
+	if lock.LockfileVersion > formatVersion {
+		return nil, fmt.Errorf("lockfile %s has version %d, this lcf supports up to %d",
+			path, lock.LockfileVersion, formatVersion)
+	}
+
+	return &lock, nil
+}
+
+// Write saves the lockfile into an output directory
+func (l *Lockfile) Write(dir string) error {
+	l.LockfileVersion = formatVersion
+
+	// Keep entries sorted so the file diffs cleanly
+	sort.Slice(l.Integrations, func(i, j int) bool {
+		return l.Integrations[i].Name < l.Integrations[j].Name
+	})
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode lockfile: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %v", dir, err)
+	}
+	if err := os.WriteFile(Path(dir), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write lockfile: %w", err)
+	}
+	return nil
+}
+
+// Find returns the entry for an integration
+func (l *Lockfile) Find(name string) (Entry, bool) {
+	for _, e := range l.Integrations {
+		if strings.EqualFold(e.Name, name) {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Set adds or replaces the entry for an integration
+func (l *Lockfile) Set(entry Entry) {
+	for i, e := range l.Integrations {
+		if strings.EqualFold(e.Name, entry.Name) {
+			l.Integrations[i] = entry
+			return
+		}
+	}
+	l.Integrations = append(l.Integrations, entry)
+}
+
+// Check compares a fetched package against the locked entry
+func (e Entry) Check(actual Entry) error {
+	if e.Version != actual.Version {
+		return fmt.Errorf("%s is locked to version %s but %s was fetched", e.Name, e.Version, actual.Version)
+	}
+	if !strings.EqualFold(e.SHA256, actual.SHA256) {
+		return fmt.Errorf("%s %s checksum mismatch: lockfile has sha256 %s, fetched package has %s",
+			e.Name, e.Version, e.SHA256, actual.SHA256)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// File: pkg/lockfile/lockfile_test.go
+
+package lockfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEntryCheck(t *testing.T) {
+	locked := Entry{Name: "AWS", Version: "1.1.118", File: "AWS_1.1.118.ssi.zip", SHA256: "abc123"}
+	tests := []struct {
+		name    string
+		actual  Entry
+		wantErr string // substring of the error, empty if the check passes
+	}{
+		{name: "match", actual: locked},
+		{name: "digest case", actual: Entry{Name: "AWS", Version: "1.1.118", SHA256: "ABC123"}},
+		{name: "other URL", actual: Entry{Name: "AWS", Version: "1.1.118", SHA256: "abc123", URL: "https://mirror"}},
+		{name: "version", actual: Entry{Name: "AWS", Version: "1.1.119", SHA256: "abc123"}, wantErr: "locked to version 1.1.118"},
+		{name: "digest", actual: Entry{Name: "AWS", Version: "1.1.118", SHA256: "def456"}, wantErr: "checksum mismatch"},
+	}
+	for _, tt := range tests {
+		err := locked.Check(tt.actual)
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("%s: error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Read(dir); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Read without a lockfile: %v, want os.ErrNotExist", err)
+	}
+
+	lock := &Lockfile{}
+	lock.Set(Entry{Name: "Slack", Version: "2.0.0", SHA256: "s1"})
+	lock.Set(Entry{Name: "AWS", Version: "1.1.117", SHA256: "a1"})
+	lock.Set(Entry{Name: "aws", Version: "1.1.118", SHA256: "a2"}) // names match case-insensitively
+	if err := lock.Write(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := Read(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.LockfileVersion != formatVersion {
+		t.Errorf("LockfileVersion = %d, want %d", read.LockfileVersion, formatVersion)
+	}
+	if len(read.Integrations) != 2 || read.Integrations[0].Name != "Slack" || read.Integrations[1].Name != "aws" {
+		t.Fatalf("Integrations = %+v, want Slack then aws (sorted by name)", read.Integrations)
+	}
+	entry, ok := read.Find("AWS")
+	if !ok || entry.Version != "1.1.118" || entry.SHA256 != "a2" {
+		t.Errorf("Find(AWS) = %+v, %v", entry, ok)
+	}
+	if _, ok := read.Find("GitHub"); ok {
+		t.Error("Find(GitHub) should not find an entry")
+	}
+}
+
+func TestReadNewerFormat(t *testing.T) {
+	dir := t.TempDir()
+	data := []byte(`{"lockfileVersion": 99, "integrations": []}`)
+	if err := os.WriteFile(filepath.Join(dir, FileName), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Read(dir); err == nil || !strings.Contains(err.Error(), "supports up to") {
+		t.Errorf("Read of a newer lockfile: %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+// File: pkg/semver/semver.go
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed semantic version (e.g. "1.1.118" or "2.0.0-beta.1")
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string // e.g. "beta.1"
+	Original   string // the string the version was parsed from
+}
+
+// Parse parses a version string. A leading "v" is accepted and missing
+// minor/patch components default to zero (e.g. "1.2" is "1.2.0").
+func Parse(s string) (Version, error) {
+	v := Version{Original: s}
+	str := strings.TrimPrefix(strings.TrimSpace(s), "v")
+
+	// Drop build metadata, it doesn't affect precedence
+	str, _, _ = strings.Cut(str, "+")
+	str, v.Prerelease, _ = strings.Cut(str, "-")
+
+	parts := strings.Split(str, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+	nums := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", s)
+		}
+		*nums[i] = n
+	}
+
+	return v, nil
+}
+
+// String returns the canonical form of the version
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 depending on whether v is lower, equal or higher than o
+func (v Version) Compare(o Version) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+
+	// A version without prerelease has higher precedence
+	switch {
+	case v.Prerelease == o.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case o.Prerelease == "":
+		return -1
+	default:
+		return comparePrerelease(v.Prerelease, o.Prerelease)
+	}
+}
+
+// comparePrerelease compares prereleases identifier by identifier (SemVer 11.4):
+// numeric identifiers numerically and below alphanumeric ones, the others in ASCII
+// order, and a prerelease that runs out of identifiers first is lower
+func comparePrerelease(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.ParseUint(as[i], 10, 64)
+		bn, bErr := strconv.ParseUint(bs[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				return compareOrder(an < bn)
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		case as[i] != bs[i]:
+			return compareOrder(as[i] < bs[i])
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
+
+// compareOrder returns -1 if less is true, 1 otherwise
+func compareOrder(less bool) int {
+	if less {
+		return -1
+	}
+	return 1
+}
+
+// Constraint is a version range such as "^1.1.0", "~1.1.0", "1.1.x" or ">=1.0.0 <2.0.0".
+// Space-separated comparisons must all match, "||" separates alternatives.
+// Operators on wildcards apply to the whole range, e.g. ">1.x" means >=2.0.0.
+type Constraint struct {
+	alternatives [][]comparison
+	original     string
+}
+
+// comparison is a single operator and version (e.g. ">=1.0.0").
+// The "outside" operator matches versions below version or at or above upper.
+type comparison struct {
+	op      string
+	version Version
+	upper   Version
+}
+
+// ParseConstraint parses a version constraint. An empty string, "*" or
+// "latest" matches every release version.
+func ParseConstraint(s string) (Constraint, error) {
+	c := Constraint{original: s}
+	if strings.TrimSpace(s) == "" {
+		c.alternatives = [][]comparison{nil}
+		return c, nil
+	}
+	for _, alt := range strings.Split(s, "||") {
+		fields := strings.Fields(alt)
+		if len(fields) == 0 {
+			return Constraint{}, fmt.Errorf("invalid version constraint %q: empty alternative", s)
+		}
+		var comps []comparison
+		for _, field := range fields {
+			expanded, err := parseComparison(field)
+			if err != nil {
+				return Constraint{}, fmt.Errorf("invalid version constraint %q: %w", s, err)
+			}
+			comps = append(comps, expanded...)
+		}
+		c.alternatives = append(c.alternatives, comps)
+	}
+	return c, nil
+}
+
+// parseComparison expands a single constraint term into comparisons
+func parseComparison(term string) ([]comparison, error) {
+	if term == "*" || term == "x" || term == "latest" {
+		return nil, nil
+	}
+
+	// Split off the operator
+	op := ""
+	for _, candidate := range []string{">=", "<=", "!=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(term, candidate) {
+			op = candidate
+			break
+		}
+	}
+	rest := strings.TrimPrefix(term, op)
+
+	// Wildcards: "1.x", "1.2.*" or partial versions like "1.2"
+	parts := strings.Split(strings.TrimPrefix(rest, "v"), ".")
+	specified := 0
+	for _, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			break
+		}
+		specified++
+	}
+	if specified < len(parts) || len(parts) < 3 {
+		return parseWildcard(op, parts[:specified])
+	}
+
+	v, err := Parse(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "^":
+		// Allow changes that don't modify the left-most non-zero component
+		var upper Version
+		switch {
+		case v.Major > 0:
+			upper = Version{Major: v.Major + 1}
+		case v.Minor > 0:
+			upper = Version{Minor: v.Minor + 1}
+		default:
+			upper = Version{Patch: v.Patch + 1}
+		}
+		return []comparison{{op: ">=", version: v}, {op: "<", version: upper}}, nil
+	case "~":
+		// Allow patch-level changes
+		return []comparison{{op: ">=", version: v}, {op: "<", version: Version{Major: v.Major, Minor: v.Minor + 1}}}, nil
+	case "":
+		return []comparison{{op: "=", version: v}}, nil
+	default:
+		return []comparison{{op: op, version: v}}, nil
+	}
+}
+
+// parseWildcard applies an operator to the range a partial version covers,
+// e.g. "1.2" covers >=1.2.0 <1.3.0, so ">1.2" means >=1.3.0 and "<=1.2" means <1.3.0
+func parseWildcard(op string, specified []string) ([]comparison, error) {
+	if len(specified) == 0 {
+		// "*" covers every version
+		switch op {
+		case "", "=", ">=", "<=", "^", "~":
+			return nil, nil
+		}
+		return nil, fmt.Errorf("operator %s cannot be applied to a wildcard", op)
+	}
+
+	lower, err := Parse(strings.Join(specified, "."))
+	if err != nil {
+		return nil, err
+	}
+	upper := Version{Major: lower.Major, Minor: lower.Minor + 1}
+	if len(specified) == 1 {
+		upper = Version{Major: lower.Major + 1}
+	}
+
+	switch op {
+	case "", "=", "~":
+		// "~1" allows minor changes like "^1", "~1.2" patch changes like "1.2"
+		return []comparison{{op: ">=", version: lower}, {op: "<", version: upper}}, nil
+	case "^":
+		// A zero major version only allows changes below the given minor version
+		if lower.Major > 0 {
+			upper = Version{Major: lower.Major + 1}
+		}
+		return []comparison{{op: ">=", version: lower}, {op: "<", version: upper}}, nil
+	case ">":
+		return []comparison{{op: ">=", version: upper}}, nil
+	case ">=":
+		return []comparison{{op: ">=", version: lower}}, nil
+	case "<":
+		return []comparison{{op: "<", version: lower}}, nil
+	case "<=":
+		return []comparison{{op: "<", version: upper}}, nil
+	default: // "!="
+		return []comparison{{op: "outside", version: lower, upper: upper}}, nil
+	}
+}
+
+// Check reports whether v satisfies the constraint.
+// Prerelease versions only match comparisons that name a prerelease explicitly.
+func (c Constraint) Check(v Version) bool {
+	for _, comps := range c.alternatives {
+		if matchesAll(comps, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAll reports whether v satisfies every comparison
+func matchesAll(comps []comparison, v Version) bool {
+	allowPrerelease := v.Prerelease == ""
+	for _, comp := range comps {
+		if comp.version.Prerelease != "" {
+			allowPrerelease = true
+		}
+		cmp := v.Compare(comp.version)
+		ok := false
+		switch comp.op {
+		case "=":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case "outside":
+			ok = cmp < 0 || v.Compare(comp.upper) >= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return allowPrerelease
+}
+
+// Exact returns the pinned version if the constraint names exactly one version
+func (c Constraint) Exact() (Version, bool) {
+	if len(c.alternatives) != 1 || len(c.alternatives[0]) != 1 || c.alternatives[0][0].op != "=" {
+		return Version{}, false
+	}
+	return c.alternatives[0][0].version, true
+}
+
+// String returns the constraint as it was written
+func (c Constraint) String() string {
+	return c.original
+}
@@ -0,0 +1,179 @@
+// File: pkg/semver/semver_test.go
+
+package semver
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "1.1.118", want: "1.1.118"},
+		{in: "v1.1.118", want: "1.1.118"},
+		{in: "1.2", want: "1.2.0"},
+		{in: "2", want: "2.0.0"},
+		{in: "2.0.0-beta.1", want: "2.0.0-beta.1"},
+		{in: "1.0.0+build.5", want: "1.0.0"},
+		{in: "1.0.0-rc.1+build.5", want: "1.0.0-rc.1"},
+		{in: "1.2.3.4", wantErr: true},
+		{in: "1.x", wantErr: true},
+		{in: "-1.0.0", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		v, err := Parse(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %s, want an error", tt.in, v)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if v.String() != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.in, v, tt.want)
+		}
+		if v.Original != tt.in {
+			t.Errorf("Parse(%q).Original = %q", tt.in, v.Original)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"v1.0.0", "1.0", 0},
+		{"1.0.0", "2.0.0", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.0.10", "1.0.9", 1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		// SemVer 11.4 precedence: 1.0.0-alpha < alpha.1 < alpha.beta < beta < beta.2 < beta.11 < rc.1 < 1.0.0
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta", "1.0.0-beta.2", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.10", "1.0.0-beta.2", 1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0-rc.1", 0},
+	}
+	for _, tt := range tests {
+		a, b := mustParse(t, tt.a), mustParse(t, tt.b)
+		if got := a.Compare(b); got != tt.want {
+			t.Errorf("Compare(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := b.Compare(a); got != -tt.want {
+			t.Errorf("Compare(%s, %s) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+func TestConstraintCheck(t *testing.T) {
+	tests := []struct {
+		constraint string
+		match      []string
+		noMatch    []string
+	}{
+		{"", []string{"0.0.1", "1.1.118"}, []string{"1.0.0-beta"}},
+		{"latest", []string{"1.0.0"}, []string{"2.0.0-rc.1"}},
+		{"*", []string{"1.0.0"}, nil},
+		{"1.1.118", []string{"1.1.118", "v1.1.118"}, []string{"1.1.117", "1.1.119"}},
+		{"=1.1.118", []string{"1.1.118"}, []string{"1.1.119"}},
+		{"^1.1.0", []string{"1.1.0", "1.9.3"}, []string{"1.0.9", "2.0.0", "1.2.0-beta"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0", "0.2.2"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"~1.1.0", []string{"1.1.0", "1.1.118"}, []string{"1.2.0", "1.0.9"}},
+		{"1.1.x", []string{"1.1.0", "1.1.5"}, []string{"1.2.0"}},
+		{"1.x", []string{"1.0.0", "1.9.0"}, []string{"2.0.0", "0.9.0"}},
+		{"1.2", []string{"1.2.0", "1.2.7"}, []string{"1.3.0"}},
+		{">=1.0.0 <2.0.0", []string{"1.0.0", "1.99.0"}, []string{"0.9.9", "2.0.0"}},
+		{"<1.0.0 || >=3.0.0", []string{"0.5.0", "3.1.0"}, []string{"1.0.0", "2.5.0"}},
+		{"!=1.0.0", []string{"1.0.1"}, []string{"1.0.0"}},
+		{">=2.0.0-beta.2", []string{"2.0.0-beta.10", "2.0.0"}, []string{"2.0.0-beta.1"}},
+		// Operators apply to the range a wildcard covers
+		{"!=1.x", []string{"0.9.9", "2.0.0"}, []string{"1.0.0", "1.5.3"}},
+		{"!=1.2", []string{"1.1.9", "1.3.0"}, []string{"1.2.0", "1.2.9"}},
+		{">1.x", []string{"2.0.0", "3.1.0"}, []string{"1.9.9", "1.0.0"}},
+		{">1.2", []string{"1.3.0"}, []string{"1.2.5"}},
+		{">=1.x", []string{"1.0.0", "2.0.0"}, []string{"0.9.9"}},
+		{"<1.x", []string{"0.9.9"}, []string{"1.0.0", "1.5.0"}},
+		{"<=1.x", []string{"1.9.9", "0.1.0"}, []string{"2.0.0"}},
+		{"<=1.2", []string{"1.2.9"}, []string{"1.3.0"}},
+		{"~1", []string{"1.0.0", "1.9.0"}, []string{"2.0.0", "0.9.0"}},
+		{"~1.2", []string{"1.2.0", "1.2.9"}, []string{"1.3.0"}},
+		{"^1.x", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{"^0.2", []string{"0.2.0", "0.2.9"}, []string{"0.3.0"}},
+		{"^0", []string{"0.0.1", "0.9.0"}, []string{"1.0.0"}},
+	}
+	for _, tt := range tests {
+		c, err := ParseConstraint(tt.constraint)
+		if err != nil {
+			t.Errorf("ParseConstraint(%q): %v", tt.constraint, err)
+			continue
+		}
+		for _, v := range tt.match {
+			if !c.Check(mustParse(t, v)) {
+				t.Errorf("%q should match %s", tt.constraint, v)
+			}
+		}
+		for _, v := range tt.noMatch {
+			if c.Check(mustParse(t, v)) {
+				t.Errorf("%q should not match %s", tt.constraint, v)
+			}
+		}
+	}
+}
+
+func TestParseConstraintErrors(t *testing.T) {
+	for _, s := range []string{">=abc", "^1.2.3.4", "1.2.3.4", "~1.a.0", "1.0.0 ||", "|| 1.0.0", "1.0.0 || || 2.0.0", "!=*", ">x"} {
+		if _, err := ParseConstraint(s); err == nil {
+			t.Errorf("ParseConstraint(%q) should fail", s)
+		}
+	}
+}
+
+func TestConstraintExact(t *testing.T) {
+	tests := []struct {
+		constraint string
+		want       string // empty if the constraint is not an exact pin
+	}{
+		{"1.1.118", "1.1.118"},
+		{"v1.1.118", "1.1.118"},
+		{"=2.0.0-rc.1", "2.0.0-rc.1"},
+		{"1.1", ""},
+		{"^1.1.0", ""},
+		{">=1.0.0", ""},
+		{"1.0.0 || 2.0.0", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		c, err := ParseConstraint(tt.constraint)
+		if err != nil {
+			t.Fatalf("ParseConstraint(%q): %v", tt.constraint, err)
+		}
+		v, ok := c.Exact()
+		switch {
+		case tt.want == "" && ok:
+			t.Errorf("Exact(%q) = %s, want no pin", tt.constraint, v)
+		case tt.want != "" && (!ok || v.String() != tt.want):
+			t.Errorf("Exact(%q) = %s, %v, want %s", tt.constraint, v, ok, tt.want)
+		}
+	}
+}
+
+func mustParse(t *testing.T, s string) Version {
+	t.Helper()
+	v, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", s, err)
+	}
+	return v
+}
@@ -0,0 +1,142 @@
+// File: pkg/source/source.go
+
+package source
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/strongcodr/lowcodefusion/pkg/fetcher"
+)
+
+// Package is a local integration package, extracted and ready to generate from
+type Package struct {
+	Def     *fetcher.IntegrationDef // name and version derived from the package
+	Dir     string                  // directory containing flows/
+	ZipPath string                  // the zip the package came from, empty for directories
+	tmpDir  string                  // extraction directory to remove on Close
+}
+
+// Open prepares a local package for generation. path is either a package zip
+// (e.g. "./AWS_1.1.118.ssi.zip") or an already-extracted directory. The integration
+// name and version are derived from the file or directory name and the flows/
+// layout; a non-empty name overrides the detected one.
+func Open(path string, name string) (*Package, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open package: %w", err)
+	}
+
+	pkg := &Package{Dir: path}
+	if !info.IsDir() {
+		// Extract the zip into a temporary directory
+		tmpDir, err := os.MkdirTemp("", "lcf-local-*")
+		if err != nil {
+			return nil, fmt.Errorf("creating temp dir: %w", err)
+		}
+		if err := fetcher.ExtractZip(path, tmpDir); err != nil {
+			os.RemoveAll(tmpDir)
+			return nil, err
+		}
+		pkg.Dir = tmpDir
+		pkg.ZipPath = path
+		pkg.tmpDir = tmpDir
+	}
+
+	def, err := detectIntegration(path, pkg.Dir, name, info.IsDir())
+	if err != nil {
+		pkg.Close()
+		return nil, err
+	}
+	pkg.Def = def
+
+	return pkg, nil
+}
+
+// Close removes any temporary extraction directory
+func (p *Package) Close() error {
+	if p.tmpDir == "" {
+		return nil
+	}
+	return os.RemoveAll(p.tmpDir)
+}
+
+// detectIntegration derives the integration definition of a local package
+func detectIntegration(path, dir, name string, extracted bool) (*fetcher.IntegrationDef, error) {
+	// Package names follow "<Name>_<version>[.ssi][.zip]", extracted
+	// directories may have kept the ".ssi" part
+	base := filepath.Base(filepath.Clean(path))
+	parsedName, version, ok := fetcher.ParsePackageFileName(base)
+	if !ok && extracted {
+		parsedName, version, ok = fetcher.ParsePackageFileName(base + ".zip")
+	}
+
+	// Integrations live in flows/<Name>
+	flows, err := Integrations(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case name != "":
+		// Explicit name wins
+	case ok && contains(flows, parsedName):
+		name = parsedName
+	case len(flows) == 1:
+		name = flows[0]
+	case len(flows) == 0:
+		return nil, fmt.Errorf("no integrations found in %s", filepath.Join(dir, "flows"))
+	default:
+		return nil, fmt.Errorf("package contains several integrations (%s), choose one with --integration",
+			strings.Join(flows, ", "))
+	}
+
+	if !contains(flows, name) {
+		return nil, fmt.Errorf("integration directory %s not found in flows", name)
+	}
+
+	// Only trust the version if the file name matches the integration
+	if !ok || parsedName != name {
+		version = ""
+	}
+
+	fileName := ""
+	if !extracted {
+		fileName = base
+	}
+
+	return &fetcher.IntegrationDef{
+		Name:     name,
+		Version:  version,
+		FileName: fileName,
+	}, nil
+}
+
+// Integrations lists the integration directories under flows/ in an extracted package
+func Integrations(dir string) ([]string, error) {
+	flowsDir := filepath.Join(dir, "flows")
+	entries, err := os.ReadDir(flowsDir)
+	if err != nil {
+		return nil, fmt.Errorf("flows directory not found in %s", dir)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// contains reports whether list holds s
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+// File: pkg/source/source_test.go
+
+package source
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// packageFiles builds an extracted package layout with one flow per integration
+func packageFiles(integrations ...string) map[string]string {
+	files := make(map[string]string)
+	for _, name := range integrations {
+		files["flows/"+name+"/Ping.json"] = `{"name": "Ping"}`
+	}
+	return files
+}
+
+// writeDir writes files below dir
+func writeDir(t *testing.T, dir string, files map[string]string) string {
+	t.Helper()
+	for rel, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// writeZip builds a zip of files in memory and writes it to dir/name
+func writeZip(t *testing.T, dir, name string, files map[string]string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for rel, content := range files {
+		w, err := zw.Create(rel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestOpenZip(t *testing.T) {
+	zipPath := writeZip(t, t.TempDir(), "AWS_1.1.118.ssi.zip", packageFiles("AWS"))
+	pkg, err := Open(zipPath, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg.Def.Name != "AWS" || pkg.Def.Version != "1.1.118" || pkg.Def.FileName != "AWS_1.1.118.ssi.zip" {
+		t.Errorf("Def = %+v", pkg.Def)
+	}
+	if pkg.ZipPath != zipPath {
+		t.Errorf("ZipPath = %s, want %s", pkg.ZipPath, zipPath)
+	}
+	if _, err := os.Stat(filepath.Join(pkg.Dir, "flows", "AWS", "Ping.json")); err != nil {
+		t.Errorf("flows not extracted: %v", err)
+	}
+
+	// Close removes the extraction directory
+	if err := pkg.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(pkg.Dir); !os.IsNotExist(err) {
+		t.Errorf("extraction directory %s was kept", pkg.Dir)
+	}
+}
+
+func TestOpenDirectory(t *testing.T) {
+	dir := writeDir(t, filepath.Join(t.TempDir(), "AWS_1.1.118.ssi"), packageFiles("AWS"))
+	pkg, err := Open(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pkg.Close()
+	if pkg.Dir != dir || pkg.ZipPath != "" {
+		t.Errorf("Dir = %s, ZipPath = %q", pkg.Dir, pkg.ZipPath)
+	}
+	if pkg.Def.Name != "AWS" || pkg.Def.Version != "1.1.118" || pkg.Def.FileName != "" {
+		t.Errorf("Def = %+v", pkg.Def)
+	}
+
+	// Closing a directory package leaves it alone
+	if err := pkg.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("Close removed the package directory: %v", err)
+	}
+}
+
+func TestDetectIntegration(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string   // package file or directory name
+		flows     []string // integrations under flows/
+		override  string
+		extracted bool
+		wantName  string
+		wantVer   string
+		wantErr   string
+	}{
+		{name: "file name", path: "Slack_2.0.0.zip", flows: []string{"AWS", "Slack"}, wantName: "Slack", wantVer: "2.0.0"},
+		{name: "directory with .ssi", path: "AWS_1.1.118.ssi", flows: []string{"AWS"}, extracted: true, wantName: "AWS", wantVer: "1.1.118"},
+		{name: "single integration", path: "download.zip", flows: []string{"AWS"}, wantName: "AWS"},
+		// A file name of another integration says nothing about the version
+		{name: "renamed file", path: "Slack_2.0.0.zip", flows: []string{"AWS"}, wantName: "AWS"},
+		{name: "override", path: "AWS_1.1.118.zip", flows: []string{"AWS", "Slack"}, override: "Slack", wantName: "Slack"},
+		{name: "override keeps version", path: "AWS_1.1.118.zip", flows: []string{"AWS", "Slack"}, override: "AWS", wantName: "AWS", wantVer: "1.1.118"},
+		{name: "ambiguous", path: "bundle.zip", flows: []string{"AWS", "Slack"}, wantErr: "several integrations (AWS, Slack)"},
+		{name: "empty", path: "empty.zip", flows: nil, wantErr: "no integrations found"},
+		{name: "unknown override", path: "AWS_1.1.118.zip", flows: []string{"AWS"}, override: "Jira", wantErr: "Jira not found"},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		writeDir(t, dir, packageFiles(tt.flows...))
+		os.MkdirAll(filepath.Join(dir, "flows"), 0755)
+
+		def, err := detectIntegration(tt.path, dir, tt.override, tt.extracted)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: error %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if def.Name != tt.wantName || def.Version != tt.wantVer {
+			t.Errorf("%s: detected %s %q, want %s %q", tt.name, def.Name, def.Version, tt.wantName, tt.wantVer)
+		}
+	}
+}
+
+func TestOpenErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Open(filepath.Join(dir, "missing.zip"), ""); err == nil || !strings.Contains(err.Error(), "cannot open package") {
+		t.Errorf("Open of a missing file: %v", err)
+	}
+
+	// A zip without flows/ is rejected and its extraction directory removed
+	zipPath := writeZip(t, dir, "AWS_1.0.0.zip", map[string]string{"README.md": "no flows"})
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	if _, err := Open(zipPath, ""); err == nil || !strings.Contains(err.Error(), "flows directory not found") {
+		t.Errorf("Open of a zip without flows: %v", err)
+	}
+	if left, _ := os.ReadDir(tmp); len(left) != 0 {
+		t.Errorf("extraction directory left behind: %s", left[0].Name())
+	}
+}